@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AuthorizationParameters are the configurable fields of an Authorization.
+type AuthorizationParameters struct {
+	// A description of the token.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org this Authorization is scoped to.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Status of the token. If inactive, requests using the token will be
+	// rejected.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+
+	// List of permissions of the token. At least one permission has to be
+	// given. Permissions cannot be changed after creation.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	Permissions []Permission `json:"permissions"`
+}
+
+// Permission defines model for Permission.
+type Permission struct {
+	// +kubebuilder:validation:Enum=read;write
+	Action string `json:"action"`
+
+	Resource PermissionResource `json:"resource"`
+}
+
+// PermissionResource is the resource that a Permission applies to.
+type PermissionResource struct {
+	// Type of the resource.
+	// +kubebuilder:validation:Enum=authorizations;buckets;checks;dashboards;dbrp;documents;labels;notebooks;notificationEndpoints;notificationRules;orgs;scrapers;secrets;sources;tasks;telegrafs;users;variables;views
+	Type string `json:"type"`
+
+	// ID of a specific resource. If not given, the permission applies to all
+	// resources of the given type.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	// +crossplane:generate:reference:refFieldName=BucketRef
+	// +crossplane:generate:reference:selectorFieldName=BucketSelector
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its ID to populate ID.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to populate BucketRef.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// OrgID is the ID of the organization whose resources the permission
+	// applies to. If not given, the permission applies to resources of all
+	// organizations.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +optional
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+}
+
+// AuthorizationObservation are the observable fields of an Authorization.
+type AuthorizationObservation struct {
+	ID        string      `json:"id,omitempty"`
+	Status    string      `json:"status,omitempty"`
+	Org       string      `json:"org,omitempty"`
+	User      string      `json:"user,omitempty"`
+	UserID    string      `json:"userID,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// An AuthorizationSpec defines the desired state of an Authorization.
+type AuthorizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthorizationParameters `json:"forProvider"`
+}
+
+// An AuthorizationStatus represents the observed state of an Authorization.
+type AuthorizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthorizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Authorization represents an API token in InfluxDB. The generated token is
+// published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Authorization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthorizationSpec   `json:"spec"`
+	Status AuthorizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthorizationList contains a list of Authorization.
+type AuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Authorization `json:"items"`
+}
+
+// Authorization type metadata.
+var (
+	AuthorizationKind             = reflect.TypeOf(Authorization{}).Name()
+	AuthorizationGroupKind        = schema.GroupKind{Group: Group, Kind: AuthorizationKind}.String()
+	AuthorizationKindAPIVersion   = AuthorizationKind + "." + SchemeGroupVersion.String()
+	AuthorizationGroupVersionKind = SchemeGroupVersion.WithKind(AuthorizationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Authorization{}, &AuthorizationList{})
+}
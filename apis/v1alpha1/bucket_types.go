@@ -29,7 +29,8 @@ type BucketParameters struct {
 	Description *string `json:"description,omitempty"`
 
 	// OrgID is the ID of the org this Bucket will be a member of.
-	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during creation.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
 	// +crossplane:generate:reference:type=Organization
 	// +crossplane:generate:reference:extractor=OrganizationID()
 	OrgID *string `json:"orgID,omitempty"`
@@ -51,6 +52,25 @@ type BucketParameters struct {
 
 	// +kubebuilder:validation:Enum=implicit;explicit
 	SchemaType string `json:"schemaType,omitempty"`
+
+	// LabelIDs is the list of IDs of the labels that should be attached to
+	// this Bucket. Labels attached to the bucket that are not in this list
+	// are detached. If not given, labels of the bucket are not managed.
+	// +crossplane:generate:reference:type=Label
+	// +crossplane:generate:reference:extractor=LabelID()
+	// +crossplane:generate:reference:refFieldName=LabelIDRefs
+	// +crossplane:generate:reference:selectorFieldName=LabelIDSelector
+	// +optional
+	LabelIDs []string `json:"labelIDs,omitempty"`
+
+	// LabelIDRefs references Labels to retrieve their IDs to populate
+	// LabelIDs.
+	// +optional
+	LabelIDRefs []xpv1.Reference `json:"labelIDRefs,omitempty"`
+
+	// LabelIDSelector selects references to Labels to populate LabelIDRefs.
+	// +optional
+	LabelIDSelector *xpv1.Selector `json:"labelIDSelector,omitempty"`
 }
 
 // RetentionRule defines model for RetentionRule.
@@ -67,12 +87,17 @@ type RetentionRule struct {
 
 // BucketObservation are the observable fields of a Bucket.
 type BucketObservation struct {
-	ID        string      `json:"id,omitempty"`
-	CreatedAt metav1.Time `json:"createdAt,omitempty"`
-	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
-	Links     BucketLinks `json:"links,omitempty"`
-	Type      string      `json:"type,omitempty"`
-	Labels    []Label     `json:"labels,omitempty"`
+	ID string `json:"id,omitempty"`
+
+	// OrgID is the ID of the organization the bucket is a member of. It is
+	// either the one given in the parameters or the default organization of
+	// the ProviderConfig.
+	OrgID     string             `json:"orgID,omitempty"`
+	CreatedAt metav1.Time        `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time        `json:"updatedAt,omitempty"`
+	Links     BucketLinks        `json:"links,omitempty"`
+	Type      string             `json:"type,omitempty"`
+	Labels    []LabelObservation `json:"labels,omitempty"`
 }
 
 // BucketLinks is the URIs of all links.
@@ -96,22 +121,6 @@ type BucketLinks struct {
 	Write string `json:"write,omitempty"`
 }
 
-// Label defines model for Label.
-type Label struct {
-	ID    string `json:"id,omitempty"`
-	Name  string `json:"name,omitempty"`
-	OrgID string `json:"orgID,omitempty"`
-
-	// Key/Value pairs associated with this label. Keys can be removed by sending an update with an empty value.
-	Properties LabelProperties `json:"properties,omitempty"`
-}
-
-// LabelProperties are Key/Value pairs associated with this label. Keys can be
-// removed by sending an update with an empty value.
-type LabelProperties struct {
-	AdditionalProperties map[string]string `json:"additionalProperties,omitempty"`
-}
-
 // A BucketSpec defines the desired state of a Bucket.
 type BucketSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Check types.
+const (
+	CheckTypeThreshold = "threshold"
+	CheckTypeDeadman   = "deadman"
+	CheckTypeCustom    = "custom"
+)
+
+// CheckParameters are the configurable fields of a Check.
+type CheckParameters struct {
+	// Name of the check. Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the check.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this Check.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Status of the check. Inactive checks are not scheduled.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+
+	// Type of the check. Thresholds are used only by threshold checks and
+	// Deadman is used only by deadman checks.
+	// +kubebuilder:validation:Enum=threshold;deadman;custom
+	// +immutable
+	Type string `json:"type"`
+
+	// Query is the Flux query that the check runs on. For custom checks, it's
+	// the raw Flux script that writes the statuses itself and it must not
+	// contain the task option since it is generated from the other fields.
+	Query string `json:"query"`
+
+	// Every is the check repetition interval, e.g. 1m.
+	// +optional
+	Every *string `json:"every,omitempty"`
+
+	// Offset is the duration to delay after the schedule, before executing
+	// the check.
+	// +optional
+	Offset *string `json:"offset,omitempty"`
+
+	// StatusMessageTemplate is the template used to generate and write a
+	// status message.
+	// +optional
+	StatusMessageTemplate *string `json:"statusMessageTemplate,omitempty"`
+
+	// Tags to write to each status.
+	// +optional
+	Tags []CheckTag `json:"tags,omitempty"`
+
+	// Thresholds of a threshold check.
+	// +optional
+	Thresholds []CheckThreshold `json:"thresholds,omitempty"`
+
+	// Deadman contains the configuration of a deadman check. It is required
+	// for checks of type deadman.
+	// +optional
+	Deadman *DeadmanParameters `json:"deadman,omitempty"`
+}
+
+// CheckTag is a tag written to each status of a check.
+type CheckTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CheckThreshold is a condition of a threshold check.
+type CheckThreshold struct {
+	// Type of the threshold. Greater and lesser thresholds use Value while
+	// range thresholds use Min, Max and Within.
+	// +kubebuilder:validation:Enum=greater;lesser;range
+	Type string `json:"type"`
+
+	// Level is the status to record if the threshold is met.
+	// +kubebuilder:validation:Enum=UNKNOWN;OK;INFO;CRIT;WARN
+	Level string `json:"level"`
+
+	// AllValues makes the check alert only if all values meet the threshold.
+	// +optional
+	AllValues *bool `json:"allValues,omitempty"`
+
+	// Value of a greater or lesser threshold, e.g. "80.5".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	Value *string `json:"value,omitempty"`
+
+	// Min of a range threshold, e.g. "10".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	Min *string `json:"min,omitempty"`
+
+	// Max of a range threshold, e.g. "90".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	Max *string `json:"max,omitempty"`
+
+	// Within makes a range threshold match values between Min and Max
+	// instead of the ones outside.
+	// +optional
+	Within *bool `json:"within,omitempty"`
+}
+
+// DeadmanParameters are the fields specific to deadman checks.
+type DeadmanParameters struct {
+	// Level is the status to record if the check matches.
+	// +kubebuilder:validation:Enum=UNKNOWN;OK;INFO;CRIT;WARN
+	Level string `json:"level"`
+
+	// TimeSince is the duration before deadman triggers, e.g. 90s.
+	TimeSince string `json:"timeSince"`
+
+	// StaleTime is the duration after which a series is considered stale and
+	// should not trigger deadman.
+	// +optional
+	StaleTime *string `json:"staleTime,omitempty"`
+
+	// ReportZero makes the check alert if only zero values are reported
+	// since TimeSince.
+	// +optional
+	ReportZero *bool `json:"reportZero,omitempty"`
+}
+
+// CheckObservation are the observable fields of a Check.
+type CheckObservation struct {
+	ID              string       `json:"id,omitempty"`
+	OwnerID         string       `json:"ownerID,omitempty"`
+	TaskID          string       `json:"taskID,omitempty"`
+	Status          string       `json:"status,omitempty"`
+	LastRunStatus   string       `json:"lastRunStatus,omitempty"`
+	LastRunError    string       `json:"lastRunError,omitempty"`
+	LatestCompleted *metav1.Time `json:"latestCompleted,omitempty"`
+	CreatedAt       metav1.Time  `json:"createdAt,omitempty"`
+	UpdatedAt       metav1.Time  `json:"updatedAt,omitempty"`
+}
+
+// A CheckSpec defines the desired state of a Check.
+type CheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CheckParameters `json:"forProvider"`
+}
+
+// A CheckStatus represents the observed state of a Check.
+type CheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CheckObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Check represents a monitoring check in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Check struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CheckSpec   `json:"spec"`
+	Status CheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CheckList contains a list of Check.
+type CheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Check `json:"items"`
+}
+
+// Check type metadata.
+var (
+	CheckKind             = reflect.TypeOf(Check{}).Name()
+	CheckGroupKind        = schema.GroupKind{Group: Group, Kind: CheckKind}.String()
+	CheckKindAPIVersion   = CheckKind + "." + SchemeGroupVersion.String()
+	CheckGroupVersionKind = SchemeGroupVersion.WithKind(CheckKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Check{}, &CheckList{})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DashboardParameters are the configurable fields of a Dashboard.
+type DashboardParameters struct {
+	// Name of the dashboard. Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The user-facing description of the dashboard.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this Dashboard.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Cells of the dashboard. Cells in the dashboard that are not in this
+	// list are deleted.
+	// +optional
+	Cells []DashboardCell `json:"cells,omitempty"`
+}
+
+// DashboardCell is a cell of a dashboard with its view.
+type DashboardCell struct {
+	// Name of the cell. It is used as the name of its view and has to be
+	// unique in the dashboard.
+	Name string `json:"name"`
+
+	// X is the horizontal position of the cell.
+	X int32 `json:"x"`
+
+	// Y is the vertical position of the cell.
+	Y int32 `json:"y"`
+
+	// W is the width of the cell.
+	W int32 `json:"w"`
+
+	// H is the height of the cell.
+	H int32 `json:"h"`
+
+	// Properties is the YAML or JSON document with the chart of the cell in
+	// the format used by the charts of dashboards in InfluxDB templates, e.g.
+	// {"kind": "XY", "queries": [{"query": "..."}], "geom": "line"}. The
+	// name, position and size of the chart are taken from the cell. Either
+	// Properties or PropertiesConfigMapRef has to be given.
+	// +optional
+	Properties *string `json:"properties,omitempty"`
+
+	// PropertiesConfigMapRef references a key of a ConfigMap that contains
+	// the chart of the cell in the same format as Properties.
+	// +optional
+	PropertiesConfigMapRef *ConfigMapKeySelector `json:"propertiesConfigMapRef,omitempty"`
+}
+
+// DashboardObservation are the observable fields of a Dashboard.
+type DashboardObservation struct {
+	ID        string      `json:"id,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+
+	// Cells are the cells that were last applied.
+	Cells []DashboardCellObservation `json:"cells,omitempty"`
+}
+
+// DashboardCellObservation is a cell of a dashboard that was applied.
+type DashboardCellObservation struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// A DashboardSpec defines the desired state of a Dashboard.
+type DashboardSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DashboardParameters `json:"forProvider"`
+}
+
+// A DashboardStatus represents the observed state of a Dashboard.
+type DashboardStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DashboardObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Dashboard represents a dashboard in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboard.
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
+
+// Dashboard type metadata.
+var (
+	DashboardKind             = reflect.TypeOf(Dashboard{}).Name()
+	DashboardGroupKind        = schema.GroupKind{Group: Group, Kind: DashboardKind}.String()
+	DashboardKindAPIVersion   = DashboardKind + "." + SchemeGroupVersion.String()
+	DashboardGroupVersionKind = SchemeGroupVersion.WithKind(DashboardKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Dashboard{}, &DashboardList{})
+}
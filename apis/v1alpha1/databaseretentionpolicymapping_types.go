@@ -51,7 +51,7 @@ type DatabaseRetentionPolicyMappingParameters struct {
 
 	// The organization that owns this mapping.
 	// Either Org or OrgRef or OrgSelector has to be given during
-	// creation.
+	// creation unless the ProviderConfig has a default organization.
 	// +crossplane:generate:reference:type=Organization
 	Org string `json:"org,omitempty"`
 
@@ -71,6 +71,11 @@ type DatabaseRetentionPolicyMappingParameters struct {
 // DatabaseRetentionPolicyMappingObservation are the observable fields of a DatabaseRetentionPolicyMapping.
 type DatabaseRetentionPolicyMappingObservation struct {
 	Links DBRPLinks `json:"links,omitempty"`
+
+	// OrgID is the ID of the organization that owns this mapping. It is
+	// either the one given in the parameters or the default organization of
+	// the ProviderConfig.
+	OrgID string `json:"orgID,omitempty"`
 }
 
 // DBRPLinks defines model for Links.
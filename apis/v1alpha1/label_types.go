@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LabelParameters are the configurable fields of a Label.
+type LabelParameters struct {
+	// OrgID is the ID of the org this Label will be a member of.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Key/Value pairs associated with this label.
+	// +optional
+	Properties LabelProperties `json:"properties,omitempty"`
+}
+
+// LabelProperties are Key/Value pairs associated with this label. Keys can be
+// removed by sending an update with an empty value.
+type LabelProperties struct {
+	// Color of the label as hex code, e.g. #326BBA.
+	// +optional
+	Color *string `json:"color,omitempty"`
+
+	// Description of the label.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AdditionalProperties are the rest of the Key/Value pairs.
+	// +optional
+	AdditionalProperties map[string]string `json:"additionalProperties,omitempty"`
+}
+
+// LabelObservation are the observable fields of a Label.
+type LabelObservation struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	OrgID string `json:"orgID,omitempty"`
+
+	// Key/Value pairs associated with this label. Keys can be removed by sending an update with an empty value.
+	Properties LabelProperties `json:"properties,omitempty"`
+}
+
+// A LabelSpec defines the desired state of a Label.
+type LabelSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LabelParameters `json:"forProvider"`
+}
+
+// A LabelStatus represents the observed state of a Label.
+type LabelStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LabelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Label represents a label in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Label struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LabelSpec   `json:"spec"`
+	Status LabelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LabelList contains a list of Label.
+type LabelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Label `json:"items"`
+}
+
+// Label type metadata.
+var (
+	LabelKind             = reflect.TypeOf(Label{}).Name()
+	LabelGroupKind        = schema.GroupKind{Group: Group, Kind: LabelKind}.String()
+	LabelKindAPIVersion   = LabelKind + "." + SchemeGroupVersion.String()
+	LabelGroupVersionKind = SchemeGroupVersion.WithKind(LabelKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Label{}, &LabelList{})
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LegacyAuthorizationParameters are the configurable fields of a
+// LegacyAuthorization.
+type LegacyAuthorizationParameters struct {
+	// Username that v1 clients log in with. Defaults to the name of the
+	// managed resource.
+	// +optional
+	// +immutable
+	Username *string `json:"username,omitempty"`
+
+	// A description of the authorization.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org this LegacyAuthorization is scoped to.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Status of the authorization. If inactive, requests using it will be
+	// rejected.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+
+	// ReadBucketIDs is the list of IDs of the buckets that can be read. It
+	// cannot be changed after creation.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	// +crossplane:generate:reference:refFieldName=ReadBucketIDRefs
+	// +crossplane:generate:reference:selectorFieldName=ReadBucketIDSelector
+	// +optional
+	// +immutable
+	ReadBucketIDs []string `json:"readBucketIDs,omitempty"`
+
+	// ReadBucketIDRefs references Buckets to retrieve their IDs to populate
+	// ReadBucketIDs.
+	// +optional
+	// +immutable
+	ReadBucketIDRefs []xpv1.Reference `json:"readBucketIDRefs,omitempty"`
+
+	// ReadBucketIDSelector selects references to Buckets to populate
+	// ReadBucketIDRefs.
+	// +optional
+	ReadBucketIDSelector *xpv1.Selector `json:"readBucketIDSelector,omitempty"`
+
+	// WriteBucketIDs is the list of IDs of the buckets that can be written
+	// to. It cannot be changed after creation.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	// +crossplane:generate:reference:refFieldName=WriteBucketIDRefs
+	// +crossplane:generate:reference:selectorFieldName=WriteBucketIDSelector
+	// +optional
+	// +immutable
+	WriteBucketIDs []string `json:"writeBucketIDs,omitempty"`
+
+	// WriteBucketIDRefs references Buckets to retrieve their IDs to populate
+	// WriteBucketIDs.
+	// +optional
+	// +immutable
+	WriteBucketIDRefs []xpv1.Reference `json:"writeBucketIDRefs,omitempty"`
+
+	// WriteBucketIDSelector selects references to Buckets to populate
+	// WriteBucketIDRefs.
+	// +optional
+	WriteBucketIDSelector *xpv1.Selector `json:"writeBucketIDSelector,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password that v1 clients log in with. The password is applied again
+	// whenever the Secret changes.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// Database is the name of the database that v1 clients use, which is
+	// published to the connection secret. It is usually the database of a
+	// DatabaseRetentionPolicyMapping of one of the buckets.
+	// +optional
+	Database *string `json:"database,omitempty"`
+}
+
+// LegacyAuthorizationObservation are the observable fields of a
+// LegacyAuthorization.
+type LegacyAuthorizationObservation struct {
+	ID        string      `json:"id,omitempty"`
+	Status    string      `json:"status,omitempty"`
+	Org       string      `json:"org,omitempty"`
+	User      string      `json:"user,omitempty"`
+	UserID    string      `json:"userID,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+
+	// PasswordSecretVersion is the resource version of the password Secret
+	// that was applied last.
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+}
+
+// A LegacyAuthorizationSpec defines the desired state of a LegacyAuthorization.
+type LegacyAuthorizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LegacyAuthorizationParameters `json:"forProvider"`
+}
+
+// A LegacyAuthorizationStatus represents the observed state of a
+// LegacyAuthorization.
+type LegacyAuthorizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LegacyAuthorizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LegacyAuthorization represents a v1 compatible authorization in InfluxDB
+// that InfluxQL clients log in with. The username, password and database are
+// published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type LegacyAuthorization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LegacyAuthorizationSpec   `json:"spec"`
+	Status LegacyAuthorizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LegacyAuthorizationList contains a list of LegacyAuthorization.
+type LegacyAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LegacyAuthorization `json:"items"`
+}
+
+// LegacyAuthorization type metadata.
+var (
+	LegacyAuthorizationKind             = reflect.TypeOf(LegacyAuthorization{}).Name()
+	LegacyAuthorizationGroupKind        = schema.GroupKind{Group: Group, Kind: LegacyAuthorizationKind}.String()
+	LegacyAuthorizationKindAPIVersion   = LegacyAuthorizationKind + "." + SchemeGroupVersion.String()
+	LegacyAuthorizationGroupVersionKind = SchemeGroupVersion.WithKind(LegacyAuthorizationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LegacyAuthorization{}, &LegacyAuthorizationList{})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Column types of a measurement schema.
+const (
+	ColumnTypeTag       = "tag"
+	ColumnTypeTimestamp = "timestamp"
+	ColumnTypeField     = "field"
+)
+
+// MeasurementSchemaColumn is a column of a measurement schema.
+type MeasurementSchemaColumn struct {
+	// Name of the column. The timestamp column has to be named time.
+	Name string `json:"name"`
+
+	// Type of the column.
+	// +kubebuilder:validation:Enum=tag;timestamp;field
+	Type string `json:"type"`
+
+	// DataType of the values of a field column. It has to be given for field
+	// columns only.
+	// +kubebuilder:validation:Enum=integer;float;boolean;string;unsigned
+	// +optional
+	DataType *string `json:"dataType,omitempty"`
+}
+
+// MeasurementSchemaParameters are the configurable fields of a
+// MeasurementSchema.
+type MeasurementSchemaParameters struct {
+	// Name of the measurement. Defaults to the name of the managed resource.
+	// +optional
+	// +immutable
+	Name *string `json:"name,omitempty"`
+
+	// OrgID is the ID of the org that owns the bucket.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// BucketID is the ID of the bucket with explicit schema type that this
+	// measurement schema belongs to.
+	// Either BucketID or BucketIDRef or BucketIDSelector has to be given
+	// during creation.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	// +immutable
+	BucketID *string `json:"bucketID,omitempty"`
+
+	// BucketIDRef references a Bucket to retrieve its ID to populate BucketID.
+	// +optional
+	// +immutable
+	BucketIDRef *xpv1.Reference `json:"bucketIDRef,omitempty"`
+
+	// BucketIDSelector selects a reference to a Bucket to populate
+	// BucketIDRef.
+	// +optional
+	BucketIDSelector *xpv1.Selector `json:"bucketIDSelector,omitempty"`
+
+	// Columns of the measurement. Columns can only be added after creation;
+	// removing a column or changing its type is rejected.
+	// +kubebuilder:validation:MinItems=1
+	Columns []MeasurementSchemaColumn `json:"columns"`
+}
+
+// MeasurementSchemaObservation are the observable fields of a
+// MeasurementSchema.
+type MeasurementSchemaObservation struct {
+	ID        string      `json:"id,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A MeasurementSchemaSpec defines the desired state of a MeasurementSchema.
+type MeasurementSchemaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MeasurementSchemaParameters `json:"forProvider"`
+}
+
+// A MeasurementSchemaStatus represents the observed state of a
+// MeasurementSchema.
+type MeasurementSchemaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MeasurementSchemaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MeasurementSchema represents the schema of a measurement in a bucket with
+// explicit schema type in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type MeasurementSchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MeasurementSchemaSpec   `json:"spec"`
+	Status MeasurementSchemaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MeasurementSchemaList contains a list of MeasurementSchema.
+type MeasurementSchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeasurementSchema `json:"items"`
+}
+
+// MeasurementSchema type metadata.
+var (
+	MeasurementSchemaKind             = reflect.TypeOf(MeasurementSchema{}).Name()
+	MeasurementSchemaGroupKind        = schema.GroupKind{Group: Group, Kind: MeasurementSchemaKind}.String()
+	MeasurementSchemaKindAPIVersion   = MeasurementSchemaKind + "." + SchemeGroupVersion.String()
+	MeasurementSchemaGroupVersionKind = SchemeGroupVersion.WithKind(MeasurementSchemaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MeasurementSchema{}, &MeasurementSchemaList{})
+}
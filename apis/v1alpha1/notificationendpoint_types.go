@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Notification endpoint types.
+const (
+	NotificationEndpointTypeHTTP      = "http"
+	NotificationEndpointTypeSlack     = "slack"
+	NotificationEndpointTypePagerDuty = "pagerduty"
+)
+
+// NotificationEndpointParameters are the configurable fields of a
+// NotificationEndpoint.
+type NotificationEndpointParameters struct {
+	// Name of the notification endpoint. Defaults to the name of the managed
+	// resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the notification endpoint.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this NotificationEndpoint.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Status of the notification endpoint.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+
+	// Type of the notification endpoint. Only the configuration block of the
+	// given type is used.
+	// +kubebuilder:validation:Enum=http;slack;pagerduty
+	// +immutable
+	Type string `json:"type"`
+
+	// HTTP contains the configuration of an http endpoint.
+	// +optional
+	HTTP *HTTPEndpointParameters `json:"http,omitempty"`
+
+	// Slack contains the configuration of a slack endpoint.
+	// +optional
+	Slack *SlackEndpointParameters `json:"slack,omitempty"`
+
+	// PagerDuty contains the configuration of a pagerduty endpoint.
+	// +optional
+	PagerDuty *PagerDutyEndpointParameters `json:"pagerduty,omitempty"`
+}
+
+// HTTPEndpointParameters are the fields specific to http endpoints.
+type HTTPEndpointParameters struct {
+	// URL that the notifications are sent to.
+	URL string `json:"url"`
+
+	// Method of the requests.
+	// +kubebuilder:default=POST
+	// +kubebuilder:validation:Enum=GET;POST;PUT
+	Method string `json:"method"`
+
+	// AuthMethod is the method used to authenticate the requests. Basic
+	// requires UsernameSecretRef and PasswordSecretRef, bearer requires
+	// TokenSecretRef.
+	// +kubebuilder:default=none
+	// +kubebuilder:validation:Enum=none;basic;bearer
+	AuthMethod string `json:"authMethod"`
+
+	// UsernameSecretRef references the key of a Secret that contains the
+	// username for basic authentication.
+	// +optional
+	UsernameSecretRef *xpv1.SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password for basic authentication.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// TokenSecretRef references the key of a Secret that contains the token
+	// for bearer authentication.
+	// +optional
+	TokenSecretRef *xpv1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+
+	// Headers to add to the requests.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// ContentTemplate is the template of the request body.
+	// +optional
+	ContentTemplate *string `json:"contentTemplate,omitempty"`
+}
+
+// SlackEndpointParameters are the fields specific to slack endpoints.
+type SlackEndpointParameters struct {
+	// URLSecretRef references the key of a Secret that contains the URL of
+	// the Slack webhook or API.
+	URLSecretRef xpv1.SecretKeySelector `json:"urlSecretRef"`
+
+	// TokenSecretRef references the key of a Secret that contains the Slack
+	// API token.
+	// +optional
+	TokenSecretRef *xpv1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+}
+
+// PagerDutyEndpointParameters are the fields specific to pagerduty endpoints.
+type PagerDutyEndpointParameters struct {
+	// ClientURL is the URL that is linked in the PagerDuty incidents.
+	// +optional
+	ClientURL *string `json:"clientURL,omitempty"`
+
+	// RoutingKeySecretRef references the key of a Secret that contains the
+	// routing key of the PagerDuty integration.
+	RoutingKeySecretRef xpv1.SecretKeySelector `json:"routingKeySecretRef"`
+}
+
+// NotificationEndpointObservation are the observable fields of a
+// NotificationEndpoint.
+type NotificationEndpointObservation struct {
+	ID        string      `json:"id,omitempty"`
+	UserID    string      `json:"userID,omitempty"`
+	Status    string      `json:"status,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+
+	// SecretVersion is the version of the referenced Secrets that was last
+	// applied. The values are applied again whenever the version of the
+	// Secrets changes, i.e. also after changes to their metadata or to keys
+	// that are not referenced, since neither the values nor a digest of them
+	// are stored. The values are also applied once more right after creation
+	// since the version cannot be recorded then.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A NotificationEndpointSpec defines the desired state of a
+// NotificationEndpoint.
+type NotificationEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationEndpointParameters `json:"forProvider"`
+}
+
+// A NotificationEndpointStatus represents the observed state of a
+// NotificationEndpoint.
+type NotificationEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotificationEndpoint represents a notification endpoint in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type NotificationEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationEndpointSpec   `json:"spec"`
+	Status NotificationEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationEndpointList contains a list of NotificationEndpoint.
+type NotificationEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationEndpoint `json:"items"`
+}
+
+// NotificationEndpoint type metadata.
+var (
+	NotificationEndpointKind             = reflect.TypeOf(NotificationEndpoint{}).Name()
+	NotificationEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: NotificationEndpointKind}.String()
+	NotificationEndpointKindAPIVersion   = NotificationEndpointKind + "." + SchemeGroupVersion.String()
+	NotificationEndpointGroupVersionKind = SchemeGroupVersion.WithKind(NotificationEndpointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NotificationEndpoint{}, &NotificationEndpointList{})
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NotificationRuleParameters are the configurable fields of a
+// NotificationRule.
+type NotificationRuleParameters struct {
+	// Name of the notification rule. Defaults to the name of the managed
+	// resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the notification rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this NotificationRule.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// EndpointID is the ID of the notification endpoint that the
+	// notifications are sent to. Either EndpointID or EndpointIDRef or
+	// EndpointIDSelector has to be given during creation.
+	// +crossplane:generate:reference:type=NotificationEndpoint
+	// +optional
+	EndpointID *string `json:"endpointID,omitempty"`
+
+	// EndpointIDRef references a NotificationEndpoint to retrieve its ID to
+	// populate EndpointID.
+	// +optional
+	EndpointIDRef *xpv1.Reference `json:"endpointIDRef,omitempty"`
+
+	// EndpointIDSelector selects a reference to a NotificationEndpoint to
+	// populate EndpointIDRef.
+	// +optional
+	EndpointIDSelector *xpv1.Selector `json:"endpointIDSelector,omitempty"`
+
+	// Status of the notification rule. Inactive rules are not scheduled.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+
+	// Type of the notification rule. It has to match the type of the
+	// notification endpoint.
+	// +kubebuilder:validation:Enum=http;slack;pagerduty
+	// +immutable
+	Type string `json:"type"`
+
+	// Every is the notification repetition interval, e.g. 10m.
+	// +optional
+	Every *string `json:"every,omitempty"`
+
+	// Offset is the duration to delay after the schedule, before executing
+	// the rule.
+	// +optional
+	Offset *string `json:"offset,omitempty"`
+
+	// StatusRules is the list of status rules the notification rule attempts
+	// to match.
+	// +kubebuilder:validation:MinItems=1
+	StatusRules []StatusRule `json:"statusRules"`
+
+	// TagRules is the list of tag rules the notification rule attempts to
+	// match.
+	// +optional
+	TagRules []TagRule `json:"tagRules,omitempty"`
+
+	// MessageTemplate is the template of the notification message. It is
+	// required for slack and pagerduty rules and not used by http rules.
+	// +optional
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
+
+	// Channel is the Slack channel to send the notifications to. Only used by
+	// slack rules.
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+}
+
+// StatusRule matches the statuses that change from a level to another.
+type StatusRule struct {
+	// CurrentLevel is the level of the status to match.
+	// +kubebuilder:validation:Enum=UNKNOWN;OK;INFO;CRIT;WARN;ANY
+	CurrentLevel string `json:"currentLevel"`
+
+	// PreviousLevel is the level that the status has to be changed from.
+	// +optional
+	// +kubebuilder:validation:Enum=UNKNOWN;OK;INFO;CRIT;WARN;ANY
+	PreviousLevel *string `json:"previousLevel,omitempty"`
+}
+
+// TagRule matches the statuses that have the given tag.
+type TagRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+
+	// +kubebuilder:default=equal
+	// +kubebuilder:validation:Enum=equal;notequal;equalregex;notequalregex
+	Operator string `json:"operator"`
+}
+
+// NotificationRuleObservation are the observable fields of a
+// NotificationRule.
+type NotificationRuleObservation struct {
+	ID              string       `json:"id,omitempty"`
+	OwnerID         string       `json:"ownerID,omitempty"`
+	TaskID          string       `json:"taskID,omitempty"`
+	Status          string       `json:"status,omitempty"`
+	LastRunStatus   string       `json:"lastRunStatus,omitempty"`
+	LastRunError    string       `json:"lastRunError,omitempty"`
+	LatestCompleted *metav1.Time `json:"latestCompleted,omitempty"`
+	CreatedAt       metav1.Time  `json:"createdAt,omitempty"`
+	UpdatedAt       metav1.Time  `json:"updatedAt,omitempty"`
+}
+
+// A NotificationRuleSpec defines the desired state of a NotificationRule.
+type NotificationRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationRuleParameters `json:"forProvider"`
+}
+
+// A NotificationRuleStatus represents the observed state of a
+// NotificationRule.
+type NotificationRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotificationRule represents a notification rule in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type NotificationRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationRuleSpec   `json:"spec"`
+	Status NotificationRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationRuleList contains a list of NotificationRule.
+type NotificationRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationRule `json:"items"`
+}
+
+// NotificationRule type metadata.
+var (
+	NotificationRuleKind             = reflect.TypeOf(NotificationRule{}).Name()
+	NotificationRuleGroupKind        = schema.GroupKind{Group: Group, Kind: NotificationRuleKind}.String()
+	NotificationRuleKindAPIVersion   = NotificationRuleKind + "." + SchemeGroupVersion.String()
+	NotificationRuleGroupVersionKind = SchemeGroupVersion.WithKind(NotificationRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NotificationRule{}, &NotificationRuleList{})
+}
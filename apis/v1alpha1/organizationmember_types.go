@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Roles of an OrganizationMember.
+const (
+	OrganizationRoleMember = "member"
+	OrganizationRoleOwner  = "owner"
+)
+
+// OrganizationMemberParameters are the configurable fields of an
+// OrganizationMember.
+type OrganizationMemberParameters struct {
+	// OrgID is the ID of the org the user will be a member of.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// UserID is the ID of the user that will be a member of the org.
+	// Either UserID or UserIDRef or UserIDSelector has to be given during
+	// creation.
+	// +crossplane:generate:reference:type=User
+	// +crossplane:generate:reference:extractor=UserID()
+	// +immutable
+	UserID *string `json:"userID,omitempty"`
+
+	// UserIDRef references a User to retrieve its ID to populate UserID.
+	// +optional
+	// +immutable
+	UserIDRef *xpv1.Reference `json:"userIDRef,omitempty"`
+
+	// UserIDSelector selects a reference to a User to populate UserIDRef.
+	// +optional
+	UserIDSelector *xpv1.Selector `json:"userIDSelector,omitempty"`
+
+	// Role of the user in the org.
+	// +kubebuilder:validation:Enum=member;owner
+	// +kubebuilder:default=member
+	Role string `json:"role"`
+}
+
+// OrganizationMemberObservation are the observable fields of an
+// OrganizationMember.
+type OrganizationMemberObservation struct {
+	Role     string `json:"role,omitempty"`
+	UserName string `json:"userName,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// An OrganizationMemberSpec defines the desired state of an OrganizationMember.
+type OrganizationMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationMemberParameters `json:"forProvider"`
+}
+
+// An OrganizationMemberStatus represents the observed state of an
+// OrganizationMember.
+type OrganizationMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationMemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationMember represents the membership or ownership of a user in an
+// organization in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".status.atProvider.role"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type OrganizationMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationMemberSpec   `json:"spec"`
+	Status OrganizationMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationMemberList contains a list of OrganizationMember.
+type OrganizationMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationMember `json:"items"`
+}
+
+// OrganizationMember type metadata.
+var (
+	OrganizationMemberKind             = reflect.TypeOf(OrganizationMember{}).Name()
+	OrganizationMemberGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationMemberKind}.String()
+	OrganizationMemberKindAPIVersion   = OrganizationMemberKind + "." + SchemeGroupVersion.String()
+	OrganizationMemberGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationMember{}, &OrganizationMemberList{})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OrganizationSecretParameters are the configurable fields of an
+// OrganizationSecret.
+type OrganizationSecretParameters struct {
+	// OrgID is the ID of the org whose secret store the keys are written to.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// SecretRef references the Secret whose keys are synced into the secret
+	// store of the org. Keys that are removed from the Secret are deleted
+	// from the secret store.
+	SecretRef xpv1.SecretReference `json:"secretRef"`
+}
+
+// OrganizationSecretObservation are the observable fields of an
+// OrganizationSecret.
+type OrganizationSecretObservation struct {
+	// Keys are the keys of the secret store that were last applied.
+	Keys []string `json:"keys,omitempty"`
+
+	// SecretVersion is the resource version of the referenced Secret that
+	// was last applied.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// An OrganizationSecretSpec defines the desired state of an
+// OrganizationSecret.
+type OrganizationSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationSecretParameters `json:"forProvider"`
+}
+
+// An OrganizationSecretStatus represents the observed state of an
+// OrganizationSecret.
+type OrganizationSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationSecret represents a set of keys in the secret store of an
+// organization in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type OrganizationSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSecretSpec   `json:"spec"`
+	Status OrganizationSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationSecretList contains a list of OrganizationSecret.
+type OrganizationSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationSecret `json:"items"`
+}
+
+// OrganizationSecret type metadata.
+var (
+	OrganizationSecretKind             = reflect.TypeOf(OrganizationSecret{}).Name()
+	OrganizationSecretGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationSecretKind}.String()
+	OrganizationSecretKindAPIVersion   = OrganizationSecretKind + "." + SchemeGroupVersion.String()
+	OrganizationSecretGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationSecretKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationSecret{}, &OrganizationSecretList{})
+}
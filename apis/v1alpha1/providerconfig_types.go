@@ -19,21 +19,144 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// AuthMode is the way the provider authenticates to InfluxDB.
+type AuthMode string
+
+// Supported authentication modes.
+const (
+	// AuthModeToken authenticates every request with an API token.
+	AuthModeToken AuthMode = "Token"
+
+	// AuthModeSession signs in with a username and password and
+	// authenticates requests with the session cookie.
+	AuthModeSession AuthMode = "Session"
+)
+
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 
 	// Endpoint is the URL of the InfluxDB instance.
 	Endpoint string `json:"endpoint"`
 
-	// Credentials required to authenticate to InfluxDB. It should point to the
-	// auth token.
-	Credentials ProviderCredentials `json:"credentials"`
+	// AuthMode is the way the provider authenticates to InfluxDB. In Token
+	// mode, the credentials have to point to an API token. In Session mode,
+	// the provider signs in with the username and password in the
+	// referenced Secrets and signs in again when the session expires.
+	// +optional
+	// +kubebuilder:validation:Enum=Token;Session
+	// +kubebuilder:default=Token
+	AuthMode AuthMode `json:"authMode,omitempty"`
+
+	// Credentials required to authenticate to InfluxDB in Token mode. It
+	// should point to the auth token.
+	// +optional
+	Credentials *ProviderCredentials `json:"credentials,omitempty"`
+
+	// UsernameSecretRef references the key of a Secret that contains the
+	// username to sign in with in Session mode.
+	// +optional
+	UsernameSecretRef *xpv1.SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password to sign in with in Session mode.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// TLS configures the TLS connection to InfluxDB. The system CAs are
+	// used if it is not given.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// HTTP configures the HTTP requests that are sent to InfluxDB.
+	// +optional
+	HTTP *HTTPConfig `json:"http,omitempty"`
+
+	// DefaultOrganization is the name or ID of the organization that managed
+	// resources use when they do not specify one. Its ID is set as
+	// spec.forProvider.orgID of the resources that give neither orgID nor a
+	// reference to an organization. DatabaseRetentionPolicyMappings record it
+	// in status.atProvider.orgID instead.
+	// +optional
+	DefaultOrganization *string `json:"defaultOrganization,omitempty"`
+}
+
+// HTTPConfig is the configuration of the HTTP requests sent to InfluxDB.
+type HTTPConfig struct {
+	// TimeoutSeconds is the timeout of a single request to InfluxDB.
+	// Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// Proxy is the proxy that the requests are sent through. No proxy is
+	// used if it is not given.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// Headers are added to every request sent to InfluxDB, e.g. a tenant
+	// header that an API gateway in front of InfluxDB requires. They
+	// override the headers the provider sets itself.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// ProxyConfig is the configuration of an HTTP proxy.
+type ProxyConfig struct {
+	// URL of the proxy, e.g. http://proxy.example.com:3128.
+	URL string `json:"url"`
+
+	// UsernameSecretRef references the key of a Secret that contains the
+	// username to authenticate to the proxy with.
+	// +optional
+	UsernameSecretRef *xpv1.SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password to authenticate to the proxy with. UsernameSecretRef has to
+	// be given as well.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// TLSConfig is the configuration of the TLS connection to InfluxDB.
+type TLSConfig struct {
+	// CASecretRef references the key of a Secret that contains the PEM
+	// encoded CA bundle to verify the server certificate with.
+	// +optional
+	CASecretRef *xpv1.SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// CAConfigMapRef references the key of a ConfigMap that contains the PEM
+	// encoded CA bundle to verify the server certificate with. It is ignored
+	// if CASecretRef is given.
+	// +optional
+	CAConfigMapRef *ConfigMapKeySelector `json:"caConfigMapRef,omitempty"`
+
+	// ClientCertSecretRef references the key of a Secret that contains the
+	// PEM encoded client certificate for mutual TLS. ClientKeySecretRef has
+	// to be given as well.
+	// +optional
+	ClientCertSecretRef *xpv1.SecretKeySelector `json:"clientCertSecretRef,omitempty"`
+
+	// ClientKeySecretRef references the key of a Secret that contains the
+	// PEM encoded private key of the client certificate.
+	// +optional
+	ClientKeySecretRef *xpv1.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+
+	// ServerName is the name to verify the server certificate against.
+	// Defaults to the host of the endpoint.
+	// +optional
+	ServerName *string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the server
+	// certificate. It should only be used for testing.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -48,12 +171,88 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Version of the InfluxDB instance.
+	Version string `json:"version,omitempty"`
+
+	// Build of the InfluxDB instance, e.g. OSS or Cloud.
+	Build string `json:"build,omitempty"`
+
+	// Commit the InfluxDB instance was built from.
+	Commit string `json:"commit,omitempty"`
+}
+
+// Reasons a ProviderConfig is or is not ready.
+const (
+	ReasonHealthy       xpv1.ConditionReason = "Healthy"
+	ReasonUnhealthy     xpv1.ConditionReason = "Unhealthy"
+	ReasonUnreachable   xpv1.ConditionReason = "Unreachable"
+	ReasonUnauthorized  xpv1.ConditionReason = "Unauthorized"
+	ReasonMisconfigured xpv1.ConditionReason = "Misconfigured"
+)
+
+// Healthy returns a condition that indicates the InfluxDB instance of the
+// ProviderConfig is healthy and accepts its credentials.
+func Healthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthy,
+	}
+}
+
+// Unhealthy returns a condition that indicates the InfluxDB instance of the
+// ProviderConfig responds but reports that it is not healthy or not ready.
+func Unhealthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnhealthy,
+	}
+}
+
+// Unreachable returns a condition that indicates the InfluxDB instance of the
+// ProviderConfig cannot be reached.
+func Unreachable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnreachable,
+	}
+}
+
+// Unauthorized returns a condition that indicates the InfluxDB instance of
+// the ProviderConfig does not accept its credentials.
+func Unauthorized() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnauthorized,
+	}
+}
+
+// Misconfigured returns a condition that indicates no client could be built
+// from the ProviderConfig, e.g. because a referenced Secret is missing.
+func Misconfigured() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMisconfigured,
+	}
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a Template provider.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="REASON",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].reason"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.version"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster
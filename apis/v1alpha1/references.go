@@ -40,3 +40,34 @@ func BucketID() reference.ExtractValueFn {
 		return ""
 	}
 }
+
+// UserID extracts ID of user from User resource.
+func UserID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if cr, ok := mg.(*User); ok {
+			return cr.Status.AtProvider.ID
+		}
+		return ""
+	}
+}
+
+// LabelID extracts ID of label from Label resource.
+func LabelID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if cr, ok := mg.(*Label); ok {
+			return cr.Status.AtProvider.ID
+		}
+		return ""
+	}
+}
+
+// RemoteConnectionID extracts ID of remote connection from RemoteConnection
+// resource.
+func RemoteConnectionID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if cr, ok := mg.(*RemoteConnection); ok {
+			return cr.Status.AtProvider.ID
+		}
+		return ""
+	}
+}
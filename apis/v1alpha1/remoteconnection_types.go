@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RemoteConnectionParameters are the configurable fields of a
+// RemoteConnection.
+type RemoteConnectionParameters struct {
+	// Name of the remote connection. Defaults to the name of the managed
+	// resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the remote connection.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the local org that owns this remote connection.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// RemoteURL is the URL of the remote InfluxDB instance.
+	RemoteURL string `json:"remoteURL"`
+
+	// RemoteOrgID is the ID of the org in the remote InfluxDB instance.
+	RemoteOrgID string `json:"remoteOrgID"`
+
+	// TokenSecretRef references the key of a Secret that contains the API
+	// token for the remote InfluxDB instance. The token is applied again
+	// whenever the Secret changes.
+	TokenSecretRef xpv1.SecretKeySelector `json:"tokenSecretRef"`
+
+	// AllowInsecureTLS skips the verification of the TLS certificate of the
+	// remote InfluxDB instance.
+	// +optional
+	AllowInsecureTLS *bool `json:"allowInsecureTLS,omitempty"`
+}
+
+// RemoteConnectionObservation are the observable fields of a
+// RemoteConnection.
+type RemoteConnectionObservation struct {
+	ID string `json:"id,omitempty"`
+
+	// TokenSecretVersion is the resource version of the token Secret that
+	// was last applied.
+	TokenSecretVersion string `json:"tokenSecretVersion,omitempty"`
+}
+
+// A RemoteConnectionSpec defines the desired state of a RemoteConnection.
+type RemoteConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RemoteConnectionParameters `json:"forProvider"`
+}
+
+// A RemoteConnectionStatus represents the observed state of a
+// RemoteConnection.
+type RemoteConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RemoteConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RemoteConnection represents a connection to a remote InfluxDB instance that
+// buckets can be replicated to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.remoteURL"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type RemoteConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RemoteConnectionSpec   `json:"spec"`
+	Status RemoteConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RemoteConnectionList contains a list of RemoteConnection.
+type RemoteConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemoteConnection `json:"items"`
+}
+
+// RemoteConnection type metadata.
+var (
+	RemoteConnectionKind             = reflect.TypeOf(RemoteConnection{}).Name()
+	RemoteConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: RemoteConnectionKind}.String()
+	RemoteConnectionKindAPIVersion   = RemoteConnectionKind + "." + SchemeGroupVersion.String()
+	RemoteConnectionGroupVersionKind = SchemeGroupVersion.WithKind(RemoteConnectionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RemoteConnection{}, &RemoteConnectionList{})
+}
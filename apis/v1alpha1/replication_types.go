@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ReplicationParameters are the configurable fields of a Replication.
+type ReplicationParameters struct {
+	// Name of the replication. Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the replication.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the local org that owns this replication.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// LocalBucketID is the ID of the local bucket whose writes are
+	// replicated.
+	// Either LocalBucketID or LocalBucketIDRef or LocalBucketIDSelector has to
+	// be given during creation.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	// +immutable
+	LocalBucketID *string `json:"localBucketID,omitempty"`
+
+	// LocalBucketIDRef references a Bucket to retrieve its ID to populate
+	// LocalBucketID.
+	// +optional
+	// +immutable
+	LocalBucketIDRef *xpv1.Reference `json:"localBucketIDRef,omitempty"`
+
+	// LocalBucketIDSelector selects a reference to a Bucket to populate
+	// LocalBucketIDRef.
+	// +optional
+	LocalBucketIDSelector *xpv1.Selector `json:"localBucketIDSelector,omitempty"`
+
+	// RemoteID is the ID of the remote connection that the data is
+	// replicated to.
+	// Either RemoteID or RemoteIDRef or RemoteIDSelector has to be given.
+	// +crossplane:generate:reference:type=RemoteConnection
+	// +crossplane:generate:reference:extractor=RemoteConnectionID()
+	RemoteID *string `json:"remoteID,omitempty"`
+
+	// RemoteIDRef references a RemoteConnection to retrieve its ID to
+	// populate RemoteID.
+	// +optional
+	RemoteIDRef *xpv1.Reference `json:"remoteIDRef,omitempty"`
+
+	// RemoteIDSelector selects a reference to a RemoteConnection to populate
+	// RemoteIDRef.
+	// +optional
+	RemoteIDSelector *xpv1.Selector `json:"remoteIDSelector,omitempty"`
+
+	// RemoteBucketID is the ID of the bucket in the remote InfluxDB instance
+	// that the data is replicated to.
+	RemoteBucketID string `json:"remoteBucketID"`
+
+	// MaxQueueSizeBytes is the maximum size of the queue of writes that are
+	// yet to be replicated. Defaults to 67108860 bytes.
+	// +optional
+	MaxQueueSizeBytes *int64 `json:"maxQueueSizeBytes,omitempty"`
+
+	// DropNonRetryableData drops the writes that the remote instance rejects
+	// with a non-retryable error instead of keeping them in the queue.
+	// +optional
+	DropNonRetryableData *bool `json:"dropNonRetryableData,omitempty"`
+}
+
+// ReplicationObservation are the observable fields of a Replication.
+type ReplicationObservation struct {
+	ID string `json:"id,omitempty"`
+
+	// CurrentQueueSizeBytes is the size of the queue of writes that are yet
+	// to be replicated.
+	CurrentQueueSizeBytes int64 `json:"currentQueueSizeBytes,omitempty"`
+
+	// LatestResponseCode is the HTTP status code of the latest response
+	// from the remote instance.
+	LatestResponseCode int32 `json:"latestResponseCode,omitempty"`
+
+	// LatestErrorMessage is the error message of the latest failed
+	// replication attempt.
+	LatestErrorMessage string `json:"latestErrorMessage,omitempty"`
+}
+
+// A ReplicationSpec defines the desired state of a Replication.
+type ReplicationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReplicationParameters `json:"forProvider"`
+}
+
+// A ReplicationStatus represents the observed state of a Replication.
+type ReplicationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReplicationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Replication represents the replication of a local bucket to a bucket in a
+// remote InfluxDB instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="QUEUE",type="integer",JSONPath=".status.atProvider.currentQueueSizeBytes"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Replication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationSpec   `json:"spec"`
+	Status ReplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationList contains a list of Replication.
+type ReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Replication `json:"items"`
+}
+
+// Replication type metadata.
+var (
+	ReplicationKind             = reflect.TypeOf(Replication{}).Name()
+	ReplicationGroupKind        = schema.GroupKind{Group: Group, Kind: ReplicationKind}.String()
+	ReplicationKindAPIVersion   = ReplicationKind + "." + SchemeGroupVersion.String()
+	ReplicationGroupVersionKind = SchemeGroupVersion.WithKind(ReplicationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Replication{}, &ReplicationList{})
+}
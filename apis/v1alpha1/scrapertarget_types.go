@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ScraperTargetParameters are the configurable fields of a ScraperTarget.
+type ScraperTargetParameters struct {
+	// Name of the scraper target. Defaults to the name of the managed
+	// resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// URL of the metrics endpoint.
+	URL string `json:"url"`
+
+	// Type of the metrics to be parsed.
+	// +kubebuilder:default=prometheus
+	// +kubebuilder:validation:Enum=prometheus
+	Type string `json:"type"`
+
+	// AllowInsecure skips the TLS verification of the endpoint.
+	// +optional
+	AllowInsecure *bool `json:"allowInsecure,omitempty"`
+
+	// OrgID is the ID of the org that owns this ScraperTarget.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// BucketID is the ID of the bucket that the scraped metrics are written
+	// to. Either BucketID or BucketIDRef or BucketIDSelector has to be given
+	// during creation.
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketID()
+	BucketID *string `json:"bucketID,omitempty"`
+
+	// BucketIDRef references a Bucket to retrieve its ID to populate BucketID.
+	// +optional
+	BucketIDRef *xpv1.Reference `json:"bucketIDRef,omitempty"`
+
+	// BucketIDSelector selects a reference to a Bucket to populate BucketIDRef.
+	// +optional
+	BucketIDSelector *xpv1.Selector `json:"bucketIDSelector,omitempty"`
+}
+
+// ScraperTargetObservation are the observable fields of a ScraperTarget.
+type ScraperTargetObservation struct {
+	ID string `json:"id,omitempty"`
+
+	// Bucket is the name of the bucket that the metrics are written to.
+	Bucket string `json:"bucket,omitempty"`
+
+	// Org is the name of the org that owns the scraper target.
+	Org string `json:"org,omitempty"`
+}
+
+// A ScraperTargetSpec defines the desired state of a ScraperTarget.
+type ScraperTargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScraperTargetParameters `json:"forProvider"`
+}
+
+// A ScraperTargetStatus represents the observed state of a ScraperTarget.
+type ScraperTargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScraperTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScraperTarget represents a scraper target in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type ScraperTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScraperTargetSpec   `json:"spec"`
+	Status ScraperTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScraperTargetList contains a list of ScraperTarget.
+type ScraperTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScraperTarget `json:"items"`
+}
+
+// ScraperTarget type metadata.
+var (
+	ScraperTargetKind             = reflect.TypeOf(ScraperTarget{}).Name()
+	ScraperTargetGroupKind        = schema.GroupKind{Group: Group, Kind: ScraperTargetKind}.String()
+	ScraperTargetKindAPIVersion   = ScraperTargetKind + "." + SchemeGroupVersion.String()
+	ScraperTargetGroupVersionKind = SchemeGroupVersion.WithKind(ScraperTargetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ScraperTarget{}, &ScraperTargetList{})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SetupParameters are the configurable fields of a Setup.
+type SetupParameters struct {
+	// Organization is the name of the initial organization.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// Bucket is the name of the initial bucket.
+	// +immutable
+	Bucket string `json:"bucket"`
+
+	// Username is the name of the initial user.
+	// +immutable
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password of the initial user.
+	// +immutable
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// RetentionPeriodSeconds is the duration in seconds for how long data
+	// will be kept in the initial bucket. 0 or omitted means infinite.
+	// +optional
+	// +immutable
+	RetentionPeriodSeconds *int64 `json:"retentionPeriodSeconds,omitempty"`
+}
+
+// SetupObservation are the observable fields of a Setup.
+type SetupObservation struct {
+	// Onboarded is true if the initial setup of the InfluxDB instance is done,
+	// whether by this resource or not.
+	Onboarded bool `json:"onboarded,omitempty"`
+}
+
+// A SetupSpec defines the desired state of a Setup.
+type SetupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SetupParameters `json:"forProvider"`
+}
+
+// A SetupStatus represents the observed state of a Setup.
+type SetupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SetupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Setup represents the initial setup (onboarding) of an InfluxDB instance.
+// The ProviderConfig it refers to is only used for its endpoint since the
+// onboarding does not need authentication. The operator token is published to
+// the connection secret under the "token" key, which a ProviderConfig can use
+// as its credentials. If the instance is already onboarded, nothing is done
+// and no token is published.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ONBOARDED",type="boolean",JSONPath=".status.atProvider.onboarded"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Setup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SetupSpec   `json:"spec"`
+	Status SetupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SetupList contains a list of Setup.
+type SetupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Setup `json:"items"`
+}
+
+// Setup type metadata.
+var (
+	SetupKind             = reflect.TypeOf(Setup{}).Name()
+	SetupGroupKind        = schema.GroupKind{Group: Group, Kind: SetupKind}.String()
+	SetupKindAPIVersion   = SetupKind + "." + SchemeGroupVersion.String()
+	SetupGroupVersionKind = SchemeGroupVersion.WithKind(SetupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Setup{}, &SetupList{})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// StackParameters are the configurable fields of a Stack.
+type StackParameters struct {
+	// Name of the stack. Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the stack.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that the template is installed to.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Template is the InfluxDB template in YAML or JSON format. Exactly one
+	// of Template, TemplateConfigMapRef or TemplateURL has to be given.
+	// +optional
+	Template *string `json:"template,omitempty"`
+
+	// TemplateConfigMapRef references a key of a ConfigMap that contains the
+	// InfluxDB template in YAML or JSON format.
+	// +optional
+	TemplateConfigMapRef *ConfigMapKeySelector `json:"templateConfigMapRef,omitempty"`
+
+	// TemplateURL is the URL of the InfluxDB template in YAML or JSON format.
+	// The template is fetched by the provider, so the URL has to be reachable
+	// from the provider, e.g. an in-cluster URL. It is fetched on every
+	// reconciliation and changes behind the URL are applied.
+	// +optional
+	TemplateURL *string `json:"templateURL,omitempty"`
+
+	// EnvRefs are the values of the environment references in the template.
+	// +optional
+	EnvRefs map[string]string `json:"envRefs,omitempty"`
+}
+
+// StackDiffEntry is a change to a resource of a stack that is reported by a
+// dry run of the template.
+type StackDiffEntry struct {
+	// Kind of the resource, e.g. Bucket.
+	Kind string `json:"kind"`
+
+	// TemplateMetaName is the name of the resource in the template.
+	TemplateMetaName string `json:"templateMetaName"`
+
+	// StateStatus is what will happen to the resource, i.e. new, exists or
+	// remove.
+	StateStatus string `json:"stateStatus,omitempty"`
+}
+
+// StackResource is a resource that is owned by a stack.
+type StackResource struct {
+	// Kind of the resource, e.g. Bucket.
+	Kind string `json:"kind"`
+
+	// TemplateMetaName is the name of the resource in the template.
+	TemplateMetaName string `json:"templateMetaName,omitempty"`
+
+	// ResourceID is the ID of the resource in InfluxDB.
+	ResourceID string `json:"resourceID,omitempty"`
+}
+
+// StackObservation are the observable fields of a Stack.
+type StackObservation struct {
+	ID        string      `json:"id,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+
+	// Resources are the resources that are owned by the stack.
+	Resources []StackResource `json:"resources,omitempty"`
+
+	// Diff is the result of the dry run of the template. It is stored
+	// before the template is applied in the next reconciliation.
+	Diff []StackDiffEntry `json:"diff,omitempty"`
+
+	// DiffHash is the hash of the template that the diff belongs to.
+	DiffHash string `json:"diffHash,omitempty"`
+
+	// TemplateHash is the hash of the template that was applied the last
+	// time.
+	TemplateHash string `json:"templateHash,omitempty"`
+}
+
+// A StackSpec defines the desired state of a Stack.
+type StackSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StackParameters `json:"forProvider"`
+}
+
+// A StackStatus represents the observed state of a Stack.
+type StackStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stack represents an InfluxDB template that is installed as a stack.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec"`
+	Status StackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackList contains a list of Stack.
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stack `json:"items"`
+}
+
+// Stack type metadata.
+var (
+	StackKind             = reflect.TypeOf(Stack{}).Name()
+	StackGroupKind        = schema.GroupKind{Group: Group, Kind: StackKind}.String()
+	StackKindAPIVersion   = StackKind + "." + SchemeGroupVersion.String()
+	StackGroupVersionKind = SchemeGroupVersion.WithKind(StackKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Stack{}, &StackList{})
+}
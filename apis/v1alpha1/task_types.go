@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// TaskParameters are the configurable fields of a Task.
+type TaskParameters struct {
+	// Name of the task. Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the task.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this Task.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Flux script to run for this task. It must not contain the task option
+	// since it is generated from the other fields. Either Flux or
+	// FluxConfigMapRef has to be given.
+	// +optional
+	Flux *string `json:"flux,omitempty"`
+
+	// FluxConfigMapRef references a key of a ConfigMap that contains the Flux
+	// script to run for this task.
+	// +optional
+	FluxConfigMapRef *ConfigMapKeySelector `json:"fluxConfigMapRef,omitempty"`
+
+	// Every is a simple task repetition schedule, e.g. 1h. Either Every or
+	// Cron has to be given.
+	// +optional
+	Every *string `json:"every,omitempty"`
+
+	// Cron is a task repetition schedule in the form '* * * * * *'.
+	// +optional
+	Cron *string `json:"cron,omitempty"`
+
+	// Offset is the duration to delay after the schedule, before executing
+	// the task.
+	// +optional
+	Offset *string `json:"offset,omitempty"`
+
+	// Status of the task. Inactive tasks are not scheduled.
+	// +optional
+	// +kubebuilder:validation:Enum=active;inactive
+	Status *string `json:"status,omitempty"`
+}
+
+// TaskObservation are the observable fields of a Task.
+type TaskObservation struct {
+	ID              string       `json:"id,omitempty"`
+	Org             string       `json:"org,omitempty"`
+	OwnerID         string       `json:"ownerID,omitempty"`
+	Status          string       `json:"status,omitempty"`
+	AuthorizationID string       `json:"authorizationID,omitempty"`
+	LastRunStatus   string       `json:"lastRunStatus,omitempty"`
+	LastRunError    string       `json:"lastRunError,omitempty"`
+	LatestCompleted *metav1.Time `json:"latestCompleted,omitempty"`
+	CreatedAt       metav1.Time  `json:"createdAt,omitempty"`
+	UpdatedAt       metav1.Time  `json:"updatedAt,omitempty"`
+}
+
+// A TaskSpec defines the desired state of a Task.
+type TaskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaskParameters `json:"forProvider"`
+}
+
+// A TaskStatus represents the observed state of a Task.
+type TaskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Task represents a Flux task in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskSpec   `json:"spec"`
+	Status TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Task.
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
+
+// Task type metadata.
+var (
+	TaskKind             = reflect.TypeOf(Task{}).Name()
+	TaskGroupKind        = schema.GroupKind{Group: Group, Kind: TaskKind}.String()
+	TaskKindAPIVersion   = TaskKind + "." + SchemeGroupVersion.String()
+	TaskGroupVersionKind = SchemeGroupVersion.WithKind(TaskKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Task{}, &TaskList{})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TaskRunParameters are the configurable fields of a TaskRun.
+type TaskRunParameters struct {
+	// TaskID is the ID of the task to run. Either TaskID, TaskIDRef,
+	// TaskIDSelector or TaskName has to be given.
+	// +crossplane:generate:reference:type=Task
+	// +optional
+	// +immutable
+	TaskID *string `json:"taskID,omitempty"`
+
+	// TaskIDRef references a Task to retrieve its ID to populate TaskID.
+	// +optional
+	// +immutable
+	TaskIDRef *xpv1.Reference `json:"taskIDRef,omitempty"`
+
+	// TaskIDSelector selects a reference to a Task to populate TaskIDRef.
+	// +optional
+	TaskIDSelector *xpv1.Selector `json:"taskIDSelector,omitempty"`
+
+	// TaskName is the name of the task to run. It is used to find the task
+	// in the given org if TaskID is not given.
+	// +optional
+	// +immutable
+	TaskName *string `json:"taskName,omitempty"`
+
+	// OrgID is the ID of the org that owns the task with TaskName. Defaults
+	// to the default organization of the ProviderConfig.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +optional
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// ScheduledFor is the time used for the "now" option of the run. Defaults
+	// to the time the run is started.
+	// +optional
+	// +immutable
+	ScheduledFor *metav1.Time `json:"scheduledFor,omitempty"`
+}
+
+// TaskRunObservation are the observable fields of a TaskRun.
+type TaskRunObservation struct {
+	RunID        string       `json:"runID,omitempty"`
+	TaskID       string       `json:"taskID,omitempty"`
+	Status       string       `json:"status,omitempty"`
+	ScheduledFor *metav1.Time `json:"scheduledFor,omitempty"`
+	RequestedAt  *metav1.Time `json:"requestedAt,omitempty"`
+	StartedAt    *metav1.Time `json:"startedAt,omitempty"`
+	FinishedAt   *metav1.Time `json:"finishedAt,omitempty"`
+
+	// Logs of the run. They are only populated if the run failed.
+	Logs []string `json:"logs,omitempty"`
+}
+
+// A TaskRunSpec defines the desired state of a TaskRun.
+type TaskRunSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaskRunParameters `json:"forProvider"`
+}
+
+// A TaskRunStatus represents the observed state of a TaskRun.
+type TaskRunStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaskRunObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaskRun represents a single manual run of a task in InfluxDB. The run is
+// started when the TaskRun is created and it's followed until it completes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type TaskRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskRunSpec   `json:"spec"`
+	Status TaskRunStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskRunList contains a list of TaskRun.
+type TaskRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaskRun `json:"items"`
+}
+
+// TaskRun type metadata.
+var (
+	TaskRunKind             = reflect.TypeOf(TaskRun{}).Name()
+	TaskRunGroupKind        = schema.GroupKind{Group: Group, Kind: TaskRunKind}.String()
+	TaskRunKindAPIVersion   = TaskRunKind + "." + SchemeGroupVersion.String()
+	TaskRunGroupVersionKind = SchemeGroupVersion.WithKind(TaskRunKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TaskRun{}, &TaskRunList{})
+}
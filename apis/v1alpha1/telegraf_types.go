@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TelegrafParameters are the configurable fields of a Telegraf.
+type TelegrafParameters struct {
+	// Name of the Telegraf configuration. Defaults to the name of the managed
+	// resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the Telegraf configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this Telegraf configuration.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Config is the Telegraf configuration in TOML format. Either Config or
+	// ConfigConfigMapRef has to be given.
+	// +optional
+	Config *string `json:"config,omitempty"`
+
+	// ConfigConfigMapRef references a key of a ConfigMap that contains the
+	// Telegraf configuration in TOML format.
+	// +optional
+	ConfigConfigMapRef *ConfigMapKeySelector `json:"configConfigMapRef,omitempty"`
+}
+
+// TelegrafObservation are the observable fields of a Telegraf.
+type TelegrafObservation struct {
+	ID string `json:"id,omitempty"`
+
+	// URL that Telegraf agents can fetch the configuration from. The request
+	// has to be authenticated with a token that can read the configuration.
+	URL string `json:"url,omitempty"`
+}
+
+// A TelegrafSpec defines the desired state of a Telegraf.
+type TelegrafSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TelegrafParameters `json:"forProvider"`
+}
+
+// A TelegrafStatus represents the observed state of a Telegraf.
+type TelegrafStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TelegrafObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Telegraf represents a Telegraf configuration in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Telegraf struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TelegrafSpec   `json:"spec"`
+	Status TelegrafStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TelegrafList contains a list of Telegraf.
+type TelegrafList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Telegraf `json:"items"`
+}
+
+// Telegraf type metadata.
+var (
+	TelegrafKind             = reflect.TypeOf(Telegraf{}).Name()
+	TelegrafGroupKind        = schema.GroupKind{Group: Group, Kind: TelegrafKind}.String()
+	TelegrafKindAPIVersion   = TelegrafKind + "." + SchemeGroupVersion.String()
+	TelegrafGroupVersionKind = SchemeGroupVersion.WithKind(TelegrafKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Telegraf{}, &TelegrafList{})
+}
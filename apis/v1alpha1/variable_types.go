@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Variable types.
+const (
+	VariableTypeQuery    = "query"
+	VariableTypeConstant = "constant"
+	VariableTypeMap      = "map"
+)
+
+// VariableParameters are the configurable fields of a Variable.
+type VariableParameters struct {
+	// Name of the variable as it is referenced in queries, e.g. v.<name>.
+	// Defaults to the name of the managed resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// An optional description of the variable.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OrgID is the ID of the org that owns this Variable.
+	// Either OrgID or OrgIDRef or OrgIDSelector has to be given during
+	// creation unless the ProviderConfig has a default organization.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +immutable
+	OrgID *string `json:"orgID,omitempty"`
+
+	// OrgIDRef references an Organization to retrieve its ID to populate OrgID.
+	// +optional
+	// +immutable
+	OrgIDRef *xpv1.Reference `json:"orgIDRef,omitempty"`
+
+	// OrgIDSelector selects a reference to an Organization to populate OrgIDRef.
+	// +optional
+	OrgIDSelector *xpv1.Selector `json:"orgIDSelector,omitempty"`
+
+	// Type of the variable. Only the configuration of the given type is
+	// used.
+	// +kubebuilder:validation:Enum=query;constant;map
+	Type string `json:"type"`
+
+	// Query contains the configuration of a query variable.
+	// +optional
+	Query *QueryVariableParameters `json:"query,omitempty"`
+
+	// Constant is the list of values of a constant variable.
+	// +optional
+	Constant []string `json:"constant,omitempty"`
+
+	// Map is the map of keys to values of a map variable.
+	// +optional
+	Map map[string]string `json:"map,omitempty"`
+
+	// Selected is the list of values that are selected by default.
+	// +optional
+	Selected []string `json:"selected,omitempty"`
+}
+
+// QueryVariableParameters are the fields specific to query variables.
+type QueryVariableParameters struct {
+	// Query whose results are the values of the variable.
+	Query string `json:"query"`
+
+	// Language of the query.
+	// +kubebuilder:default=flux
+	// +kubebuilder:validation:Enum=flux
+	Language string `json:"language"`
+}
+
+// VariableObservation are the observable fields of a Variable.
+type VariableObservation struct {
+	ID        string      `json:"id,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A VariableSpec defines the desired state of a Variable.
+type VariableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VariableParameters `json:"forProvider"`
+}
+
+// A VariableStatus represents the observed state of a Variable.
+type VariableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VariableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Variable represents a dashboard variable in InfluxDB.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,influxdb}
+type Variable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VariableSpec   `json:"spec"`
+	Status VariableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VariableList contains a list of Variable.
+type VariableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Variable `json:"items"`
+}
+
+// Variable type metadata.
+var (
+	VariableKind             = reflect.TypeOf(Variable{}).Name()
+	VariableGroupKind        = schema.GroupKind{Group: Group, Kind: VariableKind}.String()
+	VariableKindAPIVersion   = VariableKind + "." + SchemeGroupVersion.String()
+	VariableGroupVersionKind = SchemeGroupVersion.WithKind(VariableKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Variable{}, &VariableList{})
+}
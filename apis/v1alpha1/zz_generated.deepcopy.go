@@ -25,6 +25,163 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authorization) DeepCopyInto(out *Authorization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorization.
+func (in *Authorization) DeepCopy() *Authorization {
+	if in == nil {
+		return nil
+	}
+	out := new(Authorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Authorization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationList) DeepCopyInto(out *AuthorizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Authorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationList.
+func (in *AuthorizationList) DeepCopy() *AuthorizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationObservation) DeepCopyInto(out *AuthorizationObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationObservation.
+func (in *AuthorizationObservation) DeepCopy() *AuthorizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationParameters) DeepCopyInto(out *AuthorizationParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]Permission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationParameters.
+func (in *AuthorizationParameters) DeepCopy() *AuthorizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
+func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationStatus) DeepCopyInto(out *AuthorizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationStatus.
+func (in *AuthorizationStatus) DeepCopy() *AuthorizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bucket) DeepCopyInto(out *Bucket) {
 	*out = *in
@@ -107,7 +264,7 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 	out.Links = in.Links
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
-		*out = make([]Label, len(*in))
+		*out = make([]LabelObservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -159,6 +316,21 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LabelIDs != nil {
+		in, out := &in.LabelIDs, &out.LabelIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelIDRefs != nil {
+		in, out := &in.LabelIDRefs, &out.LabelIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelIDSelector != nil {
+		in, out := &in.LabelIDSelector, &out.LabelIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -206,22 +378,7 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBRPLinks) DeepCopyInto(out *DBRPLinks) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBRPLinks.
-func (in *DBRPLinks) DeepCopy() *DBRPLinks {
-	if in == nil {
-		return nil
-	}
-	out := new(DBRPLinks)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMapping) DeepCopyInto(out *DatabaseRetentionPolicyMapping) {
+func (in *Check) DeepCopyInto(out *Check) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -229,18 +386,18 @@ func (in *DatabaseRetentionPolicyMapping) DeepCopyInto(out *DatabaseRetentionPol
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMapping.
-func (in *DatabaseRetentionPolicyMapping) DeepCopy() *DatabaseRetentionPolicyMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Check.
+func (in *Check) DeepCopy() *Check {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMapping)
+	out := new(Check)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatabaseRetentionPolicyMapping) DeepCopyObject() runtime.Object {
+func (in *Check) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -248,31 +405,31 @@ func (in *DatabaseRetentionPolicyMapping) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMappingList) DeepCopyInto(out *DatabaseRetentionPolicyMappingList) {
+func (in *CheckList) DeepCopyInto(out *CheckList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatabaseRetentionPolicyMapping, len(*in))
+		*out = make([]Check, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingList.
-func (in *DatabaseRetentionPolicyMappingList) DeepCopy() *DatabaseRetentionPolicyMappingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckList.
+func (in *CheckList) DeepCopy() *CheckList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMappingList)
+	out := new(CheckList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatabaseRetentionPolicyMappingList) DeepCopyObject() runtime.Object {
+func (in *CheckList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -280,140 +437,1605 @@ func (in *DatabaseRetentionPolicyMappingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMappingObservation) DeepCopyInto(out *DatabaseRetentionPolicyMappingObservation) {
+func (in *CheckObservation) DeepCopyInto(out *CheckObservation) {
 	*out = *in
-	out.Links = in.Links
+	if in.LatestCompleted != nil {
+		in, out := &in.LatestCompleted, &out.LatestCompleted
+		*out = (*in).DeepCopy()
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingObservation.
-func (in *DatabaseRetentionPolicyMappingObservation) DeepCopy() *DatabaseRetentionPolicyMappingObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckObservation.
+func (in *CheckObservation) DeepCopy() *CheckObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMappingObservation)
+	out := new(CheckObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMappingParameters) DeepCopyInto(out *DatabaseRetentionPolicyMappingParameters) {
+func (in *CheckParameters) DeepCopyInto(out *CheckParameters) {
 	*out = *in
-	if in.BucketIDRef != nil {
-		in, out := &in.BucketIDRef, &out.BucketIDRef
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
 		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.BucketIDSelector != nil {
-		in, out := &in.BucketIDSelector, &out.BucketIDSelector
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Default != nil {
-		in, out := &in.Default, &out.Default
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.OrgRef != nil {
-		in, out := &in.OrgRef, &out.OrgRef
-		*out = new(v1.Reference)
+	if in.Every != nil {
+		in, out := &in.Every, &out.Every
+		*out = new(string)
 		**out = **in
 	}
-	if in.OrgSelector != nil {
-		in, out := &in.OrgSelector, &out.OrgSelector
-		*out = new(v1.Selector)
+	if in.Offset != nil {
+		in, out := &in.Offset, &out.Offset
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessageTemplate != nil {
+		in, out := &in.StatusMessageTemplate, &out.StatusMessageTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]CheckTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]CheckThreshold, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deadman != nil {
+		in, out := &in.Deadman, &out.Deadman
+		*out = new(DeadmanParameters)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingParameters.
-func (in *DatabaseRetentionPolicyMappingParameters) DeepCopy() *DatabaseRetentionPolicyMappingParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckParameters.
+func (in *CheckParameters) DeepCopy() *CheckParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMappingParameters)
+	out := new(CheckParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMappingSpec) DeepCopyInto(out *DatabaseRetentionPolicyMappingSpec) {
+func (in *CheckSpec) DeepCopyInto(out *CheckSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingSpec.
-func (in *DatabaseRetentionPolicyMappingSpec) DeepCopy() *DatabaseRetentionPolicyMappingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckSpec.
+func (in *CheckSpec) DeepCopy() *CheckSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMappingSpec)
+	out := new(CheckSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseRetentionPolicyMappingStatus) DeepCopyInto(out *DatabaseRetentionPolicyMappingStatus) {
+func (in *CheckStatus) DeepCopyInto(out *CheckStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingStatus.
-func (in *DatabaseRetentionPolicyMappingStatus) DeepCopy() *DatabaseRetentionPolicyMappingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckStatus.
+func (in *CheckStatus) DeepCopy() *CheckStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseRetentionPolicyMappingStatus)
+	out := new(CheckStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Label) DeepCopyInto(out *Label) {
+func (in *CheckTag) DeepCopyInto(out *CheckTag) {
 	*out = *in
-	in.Properties.DeepCopyInto(&out.Properties)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
-func (in *Label) DeepCopy() *Label {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckTag.
+func (in *CheckTag) DeepCopy() *CheckTag {
 	if in == nil {
 		return nil
 	}
-	out := new(Label)
+	out := new(CheckTag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LabelProperties) DeepCopyInto(out *LabelProperties) {
+func (in *CheckThreshold) DeepCopyInto(out *CheckThreshold) {
 	*out = *in
-	if in.AdditionalProperties != nil {
-		in, out := &in.AdditionalProperties, &out.AdditionalProperties
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.AllValues != nil {
+		in, out := &in.AllValues, &out.AllValues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(string)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(string)
+		**out = **in
+	}
+	if in.Within != nil {
+		in, out := &in.Within, &out.Within
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelProperties.
-func (in *LabelProperties) DeepCopy() *LabelProperties {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckThreshold.
+func (in *CheckThreshold) DeepCopy() *CheckThreshold {
 	if in == nil {
 		return nil
 	}
-	out := new(LabelProperties)
+	out := new(CheckThreshold)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Organization) DeepCopyInto(out *Organization) {
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBRPLinks) DeepCopyInto(out *DBRPLinks) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBRPLinks.
+func (in *DBRPLinks) DeepCopy() *DBRPLinks {
+	if in == nil {
+		return nil
+	}
+	out := new(DBRPLinks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
+func (in *Dashboard) DeepCopy() *Dashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(Dashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardCell) DeepCopyInto(out *DashboardCell) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(string)
+		**out = **in
+	}
+	if in.PropertiesConfigMapRef != nil {
+		in, out := &in.PropertiesConfigMapRef, &out.PropertiesConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCell.
+func (in *DashboardCell) DeepCopy() *DashboardCell {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardCell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardCellObservation) DeepCopyInto(out *DashboardCellObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCellObservation.
+func (in *DashboardCellObservation) DeepCopy() *DashboardCellObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardCellObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardList) DeepCopyInto(out *DashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardList.
+func (in *DashboardList) DeepCopy() *DashboardList {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardObservation) DeepCopyInto(out *DashboardObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	if in.Cells != nil {
+		in, out := &in.Cells, &out.Cells
+		*out = make([]DashboardCellObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardObservation.
+func (in *DashboardObservation) DeepCopy() *DashboardObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardParameters) DeepCopyInto(out *DashboardParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cells != nil {
+		in, out := &in.Cells, &out.Cells
+		*out = make([]DashboardCell, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardParameters.
+func (in *DashboardParameters) DeepCopy() *DashboardParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
+func (in *DashboardSpec) DeepCopy() *DashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
+func (in *DashboardStatus) DeepCopy() *DashboardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMapping) DeepCopyInto(out *DatabaseRetentionPolicyMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMapping.
+func (in *DatabaseRetentionPolicyMapping) DeepCopy() *DatabaseRetentionPolicyMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseRetentionPolicyMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMappingList) DeepCopyInto(out *DatabaseRetentionPolicyMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatabaseRetentionPolicyMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingList.
+func (in *DatabaseRetentionPolicyMappingList) DeepCopy() *DatabaseRetentionPolicyMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseRetentionPolicyMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMappingObservation) DeepCopyInto(out *DatabaseRetentionPolicyMappingObservation) {
+	*out = *in
+	out.Links = in.Links
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingObservation.
+func (in *DatabaseRetentionPolicyMappingObservation) DeepCopy() *DatabaseRetentionPolicyMappingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMappingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMappingParameters) DeepCopyInto(out *DatabaseRetentionPolicyMappingParameters) {
+	*out = *in
+	if in.BucketIDRef != nil {
+		in, out := &in.BucketIDRef, &out.BucketIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketIDSelector != nil {
+		in, out := &in.BucketIDSelector, &out.BucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingParameters.
+func (in *DatabaseRetentionPolicyMappingParameters) DeepCopy() *DatabaseRetentionPolicyMappingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMappingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMappingSpec) DeepCopyInto(out *DatabaseRetentionPolicyMappingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingSpec.
+func (in *DatabaseRetentionPolicyMappingSpec) DeepCopy() *DatabaseRetentionPolicyMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseRetentionPolicyMappingStatus) DeepCopyInto(out *DatabaseRetentionPolicyMappingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseRetentionPolicyMappingStatus.
+func (in *DatabaseRetentionPolicyMappingStatus) DeepCopy() *DatabaseRetentionPolicyMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseRetentionPolicyMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadmanParameters) DeepCopyInto(out *DeadmanParameters) {
+	*out = *in
+	if in.StaleTime != nil {
+		in, out := &in.StaleTime, &out.StaleTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReportZero != nil {
+		in, out := &in.ReportZero, &out.ReportZero
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadmanParameters.
+func (in *DeadmanParameters) DeepCopy() *DeadmanParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadmanParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPConfig.
+func (in *HTTPConfig) DeepCopy() *HTTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPEndpointParameters) DeepCopyInto(out *HTTPEndpointParameters) {
+	*out = *in
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ContentTemplate != nil {
+		in, out := &in.ContentTemplate, &out.ContentTemplate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEndpointParameters.
+func (in *HTTPEndpointParameters) DeepCopy() *HTTPEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Label) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelList) DeepCopyInto(out *LabelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Label, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelList.
+func (in *LabelList) DeepCopy() *LabelList {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LabelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelObservation) DeepCopyInto(out *LabelObservation) {
+	*out = *in
+	in.Properties.DeepCopyInto(&out.Properties)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelObservation.
+func (in *LabelObservation) DeepCopy() *LabelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelParameters) DeepCopyInto(out *LabelParameters) {
+	*out = *in
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Properties.DeepCopyInto(&out.Properties)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelParameters.
+func (in *LabelParameters) DeepCopy() *LabelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelProperties) DeepCopyInto(out *LabelProperties) {
+	*out = *in
+	if in.Color != nil {
+		in, out := &in.Color, &out.Color
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalProperties != nil {
+		in, out := &in.AdditionalProperties, &out.AdditionalProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelProperties.
+func (in *LabelProperties) DeepCopy() *LabelProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSpec) DeepCopyInto(out *LabelSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSpec.
+func (in *LabelSpec) DeepCopy() *LabelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelStatus) DeepCopyInto(out *LabelStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelStatus.
+func (in *LabelStatus) DeepCopy() *LabelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorization) DeepCopyInto(out *LegacyAuthorization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorization.
+func (in *LegacyAuthorization) DeepCopy() *LegacyAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LegacyAuthorization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorizationList) DeepCopyInto(out *LegacyAuthorizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LegacyAuthorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorizationList.
+func (in *LegacyAuthorizationList) DeepCopy() *LegacyAuthorizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LegacyAuthorizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorizationObservation) DeepCopyInto(out *LegacyAuthorizationObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorizationObservation.
+func (in *LegacyAuthorizationObservation) DeepCopy() *LegacyAuthorizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorizationParameters) DeepCopyInto(out *LegacyAuthorizationParameters) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadBucketIDs != nil {
+		in, out := &in.ReadBucketIDs, &out.ReadBucketIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadBucketIDRefs != nil {
+		in, out := &in.ReadBucketIDRefs, &out.ReadBucketIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadBucketIDSelector != nil {
+		in, out := &in.ReadBucketIDSelector, &out.ReadBucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteBucketIDs != nil {
+		in, out := &in.WriteBucketIDs, &out.WriteBucketIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteBucketIDRefs != nil {
+		in, out := &in.WriteBucketIDRefs, &out.WriteBucketIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteBucketIDSelector != nil {
+		in, out := &in.WriteBucketIDSelector, &out.WriteBucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorizationParameters.
+func (in *LegacyAuthorizationParameters) DeepCopy() *LegacyAuthorizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorizationSpec) DeepCopyInto(out *LegacyAuthorizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorizationSpec.
+func (in *LegacyAuthorizationSpec) DeepCopy() *LegacyAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyAuthorizationStatus) DeepCopyInto(out *LegacyAuthorizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyAuthorizationStatus.
+func (in *LegacyAuthorizationStatus) DeepCopy() *LegacyAuthorizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyAuthorizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchema) DeepCopyInto(out *MeasurementSchema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchema.
+func (in *MeasurementSchema) DeepCopy() *MeasurementSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeasurementSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaColumn) DeepCopyInto(out *MeasurementSchemaColumn) {
+	*out = *in
+	if in.DataType != nil {
+		in, out := &in.DataType, &out.DataType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaColumn.
+func (in *MeasurementSchemaColumn) DeepCopy() *MeasurementSchemaColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaList) DeepCopyInto(out *MeasurementSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeasurementSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaList.
+func (in *MeasurementSchemaList) DeepCopy() *MeasurementSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeasurementSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaObservation) DeepCopyInto(out *MeasurementSchemaObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaObservation.
+func (in *MeasurementSchemaObservation) DeepCopy() *MeasurementSchemaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaParameters) DeepCopyInto(out *MeasurementSchemaParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketID != nil {
+		in, out := &in.BucketID, &out.BucketID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketIDRef != nil {
+		in, out := &in.BucketIDRef, &out.BucketIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketIDSelector != nil {
+		in, out := &in.BucketIDSelector, &out.BucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]MeasurementSchemaColumn, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaParameters.
+func (in *MeasurementSchemaParameters) DeepCopy() *MeasurementSchemaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaSpec) DeepCopyInto(out *MeasurementSchemaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaSpec.
+func (in *MeasurementSchemaSpec) DeepCopy() *MeasurementSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeasurementSchemaStatus) DeepCopyInto(out *MeasurementSchemaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeasurementSchemaStatus.
+func (in *MeasurementSchemaStatus) DeepCopy() *MeasurementSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeasurementSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpoint) DeepCopyInto(out *NotificationEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpoint.
+func (in *NotificationEndpoint) DeepCopy() *NotificationEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpointList) DeepCopyInto(out *NotificationEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpointList.
+func (in *NotificationEndpointList) DeepCopy() *NotificationEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpointObservation) DeepCopyInto(out *NotificationEndpointObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpointObservation.
+func (in *NotificationEndpointObservation) DeepCopy() *NotificationEndpointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpointParameters) DeepCopyInto(out *NotificationEndpointParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPEndpointParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackEndpointParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyEndpointParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpointParameters.
+func (in *NotificationEndpointParameters) DeepCopy() *NotificationEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpointSpec) DeepCopyInto(out *NotificationEndpointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpointSpec.
+func (in *NotificationEndpointSpec) DeepCopy() *NotificationEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEndpointStatus) DeepCopyInto(out *NotificationEndpointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEndpointStatus.
+func (in *NotificationEndpointStatus) DeepCopy() *NotificationEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRule) DeepCopyInto(out *NotificationRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRule.
+func (in *NotificationRule) DeepCopy() *NotificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleList) DeepCopyInto(out *NotificationRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleList.
+func (in *NotificationRuleList) DeepCopy() *NotificationRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleObservation) DeepCopyInto(out *NotificationRuleObservation) {
+	*out = *in
+	if in.LatestCompleted != nil {
+		in, out := &in.LatestCompleted, &out.LatestCompleted
+		*out = (*in).DeepCopy()
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleObservation.
+func (in *NotificationRuleObservation) DeepCopy() *NotificationRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleParameters) DeepCopyInto(out *NotificationRuleParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EndpointID != nil {
+		in, out := &in.EndpointID, &out.EndpointID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndpointIDRef != nil {
+		in, out := &in.EndpointIDRef, &out.EndpointIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.EndpointIDSelector != nil {
+		in, out := &in.EndpointIDSelector, &out.EndpointIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Every != nil {
+		in, out := &in.Every, &out.Every
+		*out = new(string)
+		**out = **in
+	}
+	if in.Offset != nil {
+		in, out := &in.Offset, &out.Offset
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusRules != nil {
+		in, out := &in.StatusRules, &out.StatusRules
+		*out = make([]StatusRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TagRules != nil {
+		in, out := &in.TagRules, &out.TagRules
+		*out = make([]TagRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.MessageTemplate != nil {
+		in, out := &in.MessageTemplate, &out.MessageTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleParameters.
+func (in *NotificationRuleParameters) DeepCopy() *NotificationRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleSpec) DeepCopyInto(out *NotificationRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleSpec.
+func (in *NotificationRuleSpec) DeepCopy() *NotificationRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleStatus) DeepCopyInto(out *NotificationRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleStatus.
+func (in *NotificationRuleStatus) DeepCopy() *NotificationRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
@@ -421,60 +2043,2133 @@ func (in *Organization) DeepCopy() *Organization {
 	if in == nil {
 		return nil
 	}
-	out := new(Organization)
+	out := new(Organization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Organization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationLinks) DeepCopyInto(out *OrganizationLinks) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationLinks.
+func (in *OrganizationLinks) DeepCopy() *OrganizationLinks {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationLinks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMember) DeepCopyInto(out *OrganizationMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMember.
+func (in *OrganizationMember) DeepCopy() *OrganizationMember {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberList) DeepCopyInto(out *OrganizationMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberList.
+func (in *OrganizationMemberList) DeepCopy() *OrganizationMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberObservation) DeepCopyInto(out *OrganizationMemberObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberObservation.
+func (in *OrganizationMemberObservation) DeepCopy() *OrganizationMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberParameters) DeepCopyInto(out *OrganizationMemberParameters) {
+	*out = *in
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIDRef != nil {
+		in, out := &in.UserIDRef, &out.UserIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.UserIDSelector != nil {
+		in, out := &in.UserIDSelector, &out.UserIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberParameters.
+func (in *OrganizationMemberParameters) DeepCopy() *OrganizationMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberSpec) DeepCopyInto(out *OrganizationMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberSpec.
+func (in *OrganizationMemberSpec) DeepCopy() *OrganizationMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberStatus) DeepCopyInto(out *OrganizationMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberStatus.
+func (in *OrganizationMemberStatus) DeepCopy() *OrganizationMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	out.Links = in.Links
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
+func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
+func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecret) DeepCopyInto(out *OrganizationSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecret.
+func (in *OrganizationSecret) DeepCopy() *OrganizationSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecretList) DeepCopyInto(out *OrganizationSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecretList.
+func (in *OrganizationSecretList) DeepCopy() *OrganizationSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecretObservation) DeepCopyInto(out *OrganizationSecretObservation) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecretObservation.
+func (in *OrganizationSecretObservation) DeepCopy() *OrganizationSecretObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecretObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecretParameters) DeepCopyInto(out *OrganizationSecretParameters) {
+	*out = *in
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecretParameters.
+func (in *OrganizationSecretParameters) DeepCopy() *OrganizationSecretParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecretParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecretSpec) DeepCopyInto(out *OrganizationSecretSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecretSpec.
+func (in *OrganizationSecretSpec) DeepCopy() *OrganizationSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSecretStatus) DeepCopyInto(out *OrganizationSecretStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSecretStatus.
+func (in *OrganizationSecretStatus) DeepCopy() *OrganizationSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyEndpointParameters) DeepCopyInto(out *PagerDutyEndpointParameters) {
+	*out = *in
+	if in.ClientURL != nil {
+		in, out := &in.ClientURL, &out.ClientURL
+		*out = new(string)
+		**out = **in
+	}
+	out.RoutingKeySecretRef = in.RoutingKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyEndpointParameters.
+func (in *PagerDutyEndpointParameters) DeepCopy() *PagerDutyEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permission) DeepCopyInto(out *Permission) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permission.
+func (in *Permission) DeepCopy() *Permission {
+	if in == nil {
+		return nil
+	}
+	out := new(Permission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionResource) DeepCopyInto(out *PermissionResource) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionResource.
+func (in *PermissionResource) DeepCopy() *PermissionResource {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
+func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
+func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(ProviderCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultOrganization != nil {
+		in, out := &in.DefaultOrganization, &out.DefaultOrganization
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+	*out = *in
+	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
+func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigUsage) DeepCopyInto(out *ProviderConfigUsage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.ProviderConfigUsage = in.ProviderConfigUsage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigUsage.
+func (in *ProviderConfigUsage) DeepCopy() *ProviderConfigUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigUsage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigUsageList) DeepCopyInto(out *ProviderConfigUsageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfigUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigUsageList.
+func (in *ProviderConfigUsageList) DeepCopy() *ProviderConfigUsageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigUsageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
+	*out = *in
+	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCredentials.
+func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryVariableParameters) DeepCopyInto(out *QueryVariableParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryVariableParameters.
+func (in *QueryVariableParameters) DeepCopy() *QueryVariableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryVariableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnection) DeepCopyInto(out *RemoteConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnection.
+func (in *RemoteConnection) DeepCopy() *RemoteConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnectionList) DeepCopyInto(out *RemoteConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnectionList.
+func (in *RemoteConnectionList) DeepCopy() *RemoteConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnectionObservation) DeepCopyInto(out *RemoteConnectionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnectionObservation.
+func (in *RemoteConnectionObservation) DeepCopy() *RemoteConnectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnectionParameters) DeepCopyInto(out *RemoteConnectionParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.TokenSecretRef = in.TokenSecretRef
+	if in.AllowInsecureTLS != nil {
+		in, out := &in.AllowInsecureTLS, &out.AllowInsecureTLS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnectionParameters.
+func (in *RemoteConnectionParameters) DeepCopy() *RemoteConnectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnectionSpec) DeepCopyInto(out *RemoteConnectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnectionSpec.
+func (in *RemoteConnectionSpec) DeepCopy() *RemoteConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteConnectionStatus) DeepCopyInto(out *RemoteConnectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteConnectionStatus.
+func (in *RemoteConnectionStatus) DeepCopy() *RemoteConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replication) DeepCopyInto(out *Replication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replication.
+func (in *Replication) DeepCopy() *Replication {
+	if in == nil {
+		return nil
+	}
+	out := new(Replication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Replication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationList) DeepCopyInto(out *ReplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Replication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationList.
+func (in *ReplicationList) DeepCopy() *ReplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationObservation) DeepCopyInto(out *ReplicationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationObservation.
+func (in *ReplicationObservation) DeepCopy() *ReplicationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationParameters) DeepCopyInto(out *ReplicationParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalBucketID != nil {
+		in, out := &in.LocalBucketID, &out.LocalBucketID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalBucketIDRef != nil {
+		in, out := &in.LocalBucketIDRef, &out.LocalBucketIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LocalBucketIDSelector != nil {
+		in, out := &in.LocalBucketIDSelector, &out.LocalBucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteID != nil {
+		in, out := &in.RemoteID, &out.RemoteID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemoteIDRef != nil {
+		in, out := &in.RemoteIDRef, &out.RemoteIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RemoteIDSelector != nil {
+		in, out := &in.RemoteIDSelector, &out.RemoteIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxQueueSizeBytes != nil {
+		in, out := &in.MaxQueueSizeBytes, &out.MaxQueueSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DropNonRetryableData != nil {
+		in, out := &in.DropNonRetryableData, &out.DropNonRetryableData
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationParameters.
+func (in *ReplicationParameters) DeepCopy() *ReplicationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSpec) DeepCopyInto(out *ReplicationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSpec.
+func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationStatus) DeepCopyInto(out *ReplicationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationStatus.
+func (in *ReplicationStatus) DeepCopy() *ReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionRule) DeepCopyInto(out *RetentionRule) {
+	*out = *in
+	if in.ShardGroupDurationSeconds != nil {
+		in, out := &in.ShardGroupDurationSeconds, &out.ShardGroupDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionRule.
+func (in *RetentionRule) DeepCopy() *RetentionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTarget) DeepCopyInto(out *ScraperTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTarget.
+func (in *ScraperTarget) DeepCopy() *ScraperTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScraperTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTargetList) DeepCopyInto(out *ScraperTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScraperTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTargetList.
+func (in *ScraperTargetList) DeepCopy() *ScraperTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScraperTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTargetObservation) DeepCopyInto(out *ScraperTargetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTargetObservation.
+func (in *ScraperTargetObservation) DeepCopy() *ScraperTargetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTargetParameters) DeepCopyInto(out *ScraperTargetParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowInsecure != nil {
+		in, out := &in.AllowInsecure, &out.AllowInsecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketID != nil {
+		in, out := &in.BucketID, &out.BucketID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketIDRef != nil {
+		in, out := &in.BucketIDRef, &out.BucketIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketIDSelector != nil {
+		in, out := &in.BucketIDSelector, &out.BucketIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTargetParameters.
+func (in *ScraperTargetParameters) DeepCopy() *ScraperTargetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTargetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTargetSpec) DeepCopyInto(out *ScraperTargetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTargetSpec.
+func (in *ScraperTargetSpec) DeepCopy() *ScraperTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScraperTargetStatus) DeepCopyInto(out *ScraperTargetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScraperTargetStatus.
+func (in *ScraperTargetStatus) DeepCopy() *ScraperTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScraperTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Setup) DeepCopyInto(out *Setup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Setup.
+func (in *Setup) DeepCopy() *Setup {
+	if in == nil {
+		return nil
+	}
+	out := new(Setup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Setup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupList) DeepCopyInto(out *SetupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Setup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupList.
+func (in *SetupList) DeepCopy() *SetupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SetupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupObservation) DeepCopyInto(out *SetupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupObservation.
+func (in *SetupObservation) DeepCopy() *SetupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupParameters) DeepCopyInto(out *SetupParameters) {
+	*out = *in
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.RetentionPeriodSeconds != nil {
+		in, out := &in.RetentionPeriodSeconds, &out.RetentionPeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupParameters.
+func (in *SetupParameters) DeepCopy() *SetupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupSpec) DeepCopyInto(out *SetupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupSpec.
+func (in *SetupSpec) DeepCopy() *SetupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupStatus) DeepCopyInto(out *SetupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupStatus.
+func (in *SetupStatus) DeepCopy() *SetupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackEndpointParameters) DeepCopyInto(out *SlackEndpointParameters) {
+	*out = *in
+	out.URLSecretRef = in.URLSecretRef
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackEndpointParameters.
+func (in *SlackEndpointParameters) DeepCopy() *SlackEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stack) DeepCopyInto(out *Stack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stack.
+func (in *Stack) DeepCopy() *Stack {
+	if in == nil {
+		return nil
+	}
+	out := new(Stack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Stack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackDiffEntry) DeepCopyInto(out *StackDiffEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackDiffEntry.
+func (in *StackDiffEntry) DeepCopy() *StackDiffEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(StackDiffEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackList) DeepCopyInto(out *StackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Stack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackList.
+func (in *StackList) DeepCopy() *StackList {
+	if in == nil {
+		return nil
+	}
+	out := new(StackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackObservation) DeepCopyInto(out *StackObservation) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]StackResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = make([]StackDiffEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackObservation.
+func (in *StackObservation) DeepCopy() *StackObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StackObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackParameters) DeepCopyInto(out *StackParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateConfigMapRef != nil {
+		in, out := &in.TemplateConfigMapRef, &out.TemplateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.TemplateURL != nil {
+		in, out := &in.TemplateURL, &out.TemplateURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnvRefs != nil {
+		in, out := &in.EnvRefs, &out.EnvRefs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackParameters.
+func (in *StackParameters) DeepCopy() *StackParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StackParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackResource) DeepCopyInto(out *StackResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackResource.
+func (in *StackResource) DeepCopy() *StackResource {
+	if in == nil {
+		return nil
+	}
+	out := new(StackResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackSpec) DeepCopyInto(out *StackSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSpec.
+func (in *StackSpec) DeepCopy() *StackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackStatus) DeepCopyInto(out *StackStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackStatus.
+func (in *StackStatus) DeepCopy() *StackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusRule) DeepCopyInto(out *StatusRule) {
+	*out = *in
+	if in.PreviousLevel != nil {
+		in, out := &in.PreviousLevel, &out.PreviousLevel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusRule.
+func (in *StatusRule) DeepCopy() *StatusRule {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CAConfigMapRef != nil {
+		in, out := &in.CAConfigMapRef, &out.CAConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ServerName != nil {
+		in, out := &in.ServerName, &out.ServerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagRule) DeepCopyInto(out *TagRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagRule.
+func (in *TagRule) DeepCopy() *TagRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TagRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Task) DeepCopyInto(out *Task) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
+	if in == nil {
+		return nil
+	}
+	out := new(Task)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Task) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskList) DeepCopyInto(out *TaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Task, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
+func (in *TaskList) DeepCopy() *TaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskObservation) DeepCopyInto(out *TaskObservation) {
+	*out = *in
+	if in.LatestCompleted != nil {
+		in, out := &in.LatestCompleted, &out.LatestCompleted
+		*out = (*in).DeepCopy()
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskObservation.
+func (in *TaskObservation) DeepCopy() *TaskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskParameters) DeepCopyInto(out *TaskParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flux != nil {
+		in, out := &in.Flux, &out.Flux
+		*out = new(string)
+		**out = **in
+	}
+	if in.FluxConfigMapRef != nil {
+		in, out := &in.FluxConfigMapRef, &out.FluxConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.Every != nil {
+		in, out := &in.Every, &out.Every
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cron != nil {
+		in, out := &in.Cron, &out.Cron
+		*out = new(string)
+		**out = **in
+	}
+	if in.Offset != nil {
+		in, out := &in.Offset, &out.Offset
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskParameters.
+func (in *TaskParameters) DeepCopy() *TaskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRun) DeepCopyInto(out *TaskRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRun.
+func (in *TaskRun) DeepCopy() *TaskRun {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaskRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunList) DeepCopyInto(out *TaskRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TaskRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunList.
+func (in *TaskRunList) DeepCopy() *TaskRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaskRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunObservation) DeepCopyInto(out *TaskRunObservation) {
+	*out = *in
+	if in.ScheduledFor != nil {
+		in, out := &in.ScheduledFor, &out.ScheduledFor
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunObservation.
+func (in *TaskRunObservation) DeepCopy() *TaskRunObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunParameters) DeepCopyInto(out *TaskRunParameters) {
+	*out = *in
+	if in.TaskID != nil {
+		in, out := &in.TaskID, &out.TaskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaskIDRef != nil {
+		in, out := &in.TaskIDRef, &out.TaskIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TaskIDSelector != nil {
+		in, out := &in.TaskIDSelector, &out.TaskIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaskName != nil {
+		in, out := &in.TaskName, &out.TaskName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledFor != nil {
+		in, out := &in.ScheduledFor, &out.ScheduledFor
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunParameters.
+func (in *TaskRunParameters) DeepCopy() *TaskRunParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Organization) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunSpec) DeepCopyInto(out *TaskRunSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunSpec.
+func (in *TaskRunSpec) DeepCopy() *TaskRunSpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(TaskRunSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationLinks) DeepCopyInto(out *OrganizationLinks) {
+func (in *TaskRunStatus) DeepCopyInto(out *TaskRunStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationLinks.
-func (in *OrganizationLinks) DeepCopy() *OrganizationLinks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunStatus.
+func (in *TaskRunStatus) DeepCopy() *TaskRunStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationLinks)
+	out := new(TaskRunStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSpec.
+func (in *TaskSpec) DeepCopy() *TaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStatus.
+func (in *TaskStatus) DeepCopy() *TaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Telegraf) DeepCopyInto(out *Telegraf) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Telegraf.
+func (in *Telegraf) DeepCopy() *Telegraf {
+	if in == nil {
+		return nil
+	}
+	out := new(Telegraf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Telegraf) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelegrafList) DeepCopyInto(out *TelegrafList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Organization, len(*in))
+		*out = make([]Telegraf, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
-func (in *OrganizationList) DeepCopy() *OrganizationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegrafList.
+func (in *TelegrafList) DeepCopy() *TelegrafList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationList)
+	out := new(TelegrafList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationList) DeepCopyObject() runtime.Object {
+func (in *TelegrafList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -482,79 +4177,106 @@ func (in *OrganizationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
+func (in *TelegrafObservation) DeepCopyInto(out *TelegrafObservation) {
 	*out = *in
-	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
-	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
-	out.Links = in.Links
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
-func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegrafObservation.
+func (in *TelegrafObservation) DeepCopy() *TelegrafObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationObservation)
+	out := new(TelegrafObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
+func (in *TelegrafParameters) DeepCopyInto(out *TelegrafParameters) {
 	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigConfigMapRef != nil {
+		in, out := &in.ConfigConfigMapRef, &out.ConfigConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
-func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegrafParameters.
+func (in *TelegrafParameters) DeepCopy() *TelegrafParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationParameters)
+	out := new(TelegrafParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+func (in *TelegrafSpec) DeepCopyInto(out *TelegrafSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
-func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegrafSpec.
+func (in *TelegrafSpec) DeepCopy() *TelegrafSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationSpec)
+	out := new(TelegrafSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+func (in *TelegrafStatus) DeepCopyInto(out *TelegrafStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
-func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegrafStatus.
+func (in *TelegrafStatus) DeepCopy() *TelegrafStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationStatus)
+	out := new(TelegrafStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+func (in *User) DeepCopyInto(out *User) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -562,18 +4284,18 @@ func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
-func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfig)
+	out := new(User)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+func (in *User) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -581,31 +4303,31 @@ func (in *ProviderConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProviderConfig, len(*in))
+		*out = make([]User, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
-func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigList)
+	out := new(UserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+func (in *UserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -613,57 +4335,100 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
 	*out = *in
-	in.Credentials.DeepCopyInto(&out.Credentials)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
-func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigSpec)
+	out := new(UserObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 	*out = *in
-	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
-func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
+func (in *UserParameters) DeepCopy() *UserParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigStatus)
+	out := new(UserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigUsage) DeepCopyInto(out *ProviderConfigUsage) {
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Variable) DeepCopyInto(out *Variable) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.ProviderConfigUsage = in.ProviderConfigUsage
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigUsage.
-func (in *ProviderConfigUsage) DeepCopy() *ProviderConfigUsage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
+func (in *Variable) DeepCopy() *Variable {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigUsage)
+	out := new(Variable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfigUsage) DeepCopyObject() runtime.Object {
+func (in *Variable) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -671,31 +4436,31 @@ func (in *ProviderConfigUsage) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigUsageList) DeepCopyInto(out *ProviderConfigUsageList) {
+func (in *VariableList) DeepCopyInto(out *VariableList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProviderConfigUsage, len(*in))
+		*out = make([]Variable, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigUsageList.
-func (in *ProviderConfigUsageList) DeepCopy() *ProviderConfigUsageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableList.
+func (in *VariableList) DeepCopy() *VariableList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigUsageList)
+	out := new(VariableList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
+func (in *VariableList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -703,37 +4468,114 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
 	*out = *in
-	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCredentials.
-func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderCredentials)
+	out := new(VariableObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetentionRule) DeepCopyInto(out *RetentionRule) {
+func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
-	if in.ShardGroupDurationSeconds != nil {
-		in, out := &in.ShardGroupDurationSeconds, &out.ShardGroupDurationSeconds
-		*out = new(int64)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgID != nil {
+		in, out := &in.OrgID, &out.OrgID
+		*out = new(string)
 		**out = **in
 	}
+	if in.OrgIDRef != nil {
+		in, out := &in.OrgIDRef, &out.OrgIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OrgIDSelector != nil {
+		in, out := &in.OrgIDSelector, &out.OrgIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(QueryVariableParameters)
+		**out = **in
+	}
+	if in.Constant != nil {
+		in, out := &in.Constant, &out.Constant
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Map != nil {
+		in, out := &in.Map, &out.Map
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Selected != nil {
+		in, out := &in.Selected, &out.Selected
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionRule.
-func (in *RetentionRule) DeepCopy() *RetentionRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
+func (in *VariableParameters) DeepCopy() *VariableParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RetentionRule)
+	out := new(VariableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSpec) DeepCopyInto(out *VariableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSpec.
+func (in *VariableSpec) DeepCopy() *VariableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
+func (in *VariableStatus) DeepCopy() *VariableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// AuthorizationsAPI is the set of calls we make in controllers that use
+// Authorizations API.
+type AuthorizationsAPI interface {
+	// FindAuthorizationsByOrgID returns all authorizations for the org with
+	// the given ID.
+	FindAuthorizationsByOrgID(ctx context.Context, orgID string) (*[]domain.Authorization, error)
+
+	// CreateAuthorization creates a new authorization.
+	CreateAuthorization(ctx context.Context, authorization *domain.Authorization) (*domain.Authorization, error)
+
+	// DeleteAuthorizationWithID deletes the authorization with the given ID.
+	DeleteAuthorizationWithID(ctx context.Context, authID string) error
+}
+
+// MockAuthorizationsAPI mocks AuthorizationsAPI.
+type MockAuthorizationsAPI struct {
+	FindAuthorizationsByOrgIDFn func(ctx context.Context, orgID string) (*[]domain.Authorization, error)
+	CreateAuthorizationFn       func(ctx context.Context, authorization *domain.Authorization) (*domain.Authorization, error)
+	DeleteAuthorizationWithIDFn func(ctx context.Context, authID string) error
+}
+
+// FindAuthorizationsByOrgID calls FindAuthorizationsByOrgIDFn.
+func (m *MockAuthorizationsAPI) FindAuthorizationsByOrgID(ctx context.Context, orgID string) (*[]domain.Authorization, error) {
+	return m.FindAuthorizationsByOrgIDFn(ctx, orgID)
+}
+
+// CreateAuthorization calls CreateAuthorizationFn.
+func (m *MockAuthorizationsAPI) CreateAuthorization(ctx context.Context, authorization *domain.Authorization) (*domain.Authorization, error) {
+	return m.CreateAuthorizationFn(ctx, authorization)
+}
+
+// DeleteAuthorizationWithID calls DeleteAuthorizationWithIDFn.
+func (m *MockAuthorizationsAPI) DeleteAuthorizationWithID(ctx context.Context, authID string) error {
+	return m.DeleteAuthorizationWithIDFn(ctx, authID)
+}
+
+// AuthorizationUpdateAPI is the set of calls we make in controllers to update
+// the description and the status of authorizations.
+type AuthorizationUpdateAPI interface {
+	PatchAuthorizationsIDWithResponse(ctx context.Context, authID string, params *domain.PatchAuthorizationsIDParams, body domain.PatchAuthorizationsIDJSONRequestBody) (*domain.PatchAuthorizationsIDResponse, error)
+}
+
+// MockAuthorizationUpdateAPI mocks AuthorizationUpdateAPI.
+type MockAuthorizationUpdateAPI struct {
+	PatchAuthorizationsIDWithResponseFn func(ctx context.Context, authID string, params *domain.PatchAuthorizationsIDParams, body domain.PatchAuthorizationsIDJSONRequestBody) (*domain.PatchAuthorizationsIDResponse, error)
+}
+
+// PatchAuthorizationsIDWithResponse calls PatchAuthorizationsIDWithResponseFn.
+func (m *MockAuthorizationUpdateAPI) PatchAuthorizationsIDWithResponse(ctx context.Context, authID string, params *domain.PatchAuthorizationsIDParams, body domain.PatchAuthorizationsIDJSONRequestBody) (*domain.PatchAuthorizationsIDResponse, error) {
+	return m.PatchAuthorizationsIDWithResponseFn(ctx, authID, params, body)
+}
@@ -65,3 +65,26 @@ func (m *MockBucketsAPI) UpdateBucket(ctx context.Context, org *domain.Bucket) (
 func (m *MockBucketsAPI) DeleteBucket(ctx context.Context, org *domain.Bucket) error {
 	return m.DeleteBucketFn(ctx, org)
 }
+
+// BucketLabelsAPI is the set of calls we make in controllers to manage the
+// labels attached to a bucket.
+type BucketLabelsAPI interface {
+	PostBucketsIDLabelsWithResponse(ctx context.Context, bucketID string, params *domain.PostBucketsIDLabelsParams, body domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error)
+	DeleteBucketsIDLabelsIDWithResponse(ctx context.Context, bucketID string, labelID string, params *domain.DeleteBucketsIDLabelsIDParams) (*domain.DeleteBucketsIDLabelsIDResponse, error)
+}
+
+// MockBucketLabelsAPI mocks BucketLabelsAPI.
+type MockBucketLabelsAPI struct {
+	PostBucketsIDLabelsWithResponseFn     func(ctx context.Context, bucketID string, params *domain.PostBucketsIDLabelsParams, body domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error)
+	DeleteBucketsIDLabelsIDWithResponseFn func(ctx context.Context, bucketID string, labelID string, params *domain.DeleteBucketsIDLabelsIDParams) (*domain.DeleteBucketsIDLabelsIDResponse, error)
+}
+
+// PostBucketsIDLabelsWithResponse calls PostBucketsIDLabelsWithResponseFn.
+func (m *MockBucketLabelsAPI) PostBucketsIDLabelsWithResponse(ctx context.Context, bucketID string, params *domain.PostBucketsIDLabelsParams, body domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error) {
+	return m.PostBucketsIDLabelsWithResponseFn(ctx, bucketID, params, body)
+}
+
+// DeleteBucketsIDLabelsIDWithResponse calls DeleteBucketsIDLabelsIDWithResponseFn.
+func (m *MockBucketLabelsAPI) DeleteBucketsIDLabelsIDWithResponse(ctx context.Context, bucketID string, labelID string, params *domain.DeleteBucketsIDLabelsIDParams) (*domain.DeleteBucketsIDLabelsIDResponse, error) {
+	return m.DeleteBucketsIDLabelsIDWithResponseFn(ctx, bucketID, labelID, params)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Check is the union of the threshold, deadman and custom check models of
+// InfluxDB API. The generated domain.Check cannot be used for serialization
+// since its fields depend on its type.
+type Check struct {
+	domain.CheckBase
+
+	Type                  string                   `json:"type"`
+	Every                 *string                  `json:"every,omitempty"`
+	Offset                *string                  `json:"offset,omitempty"`
+	StatusMessageTemplate *string                  `json:"statusMessageTemplate,omitempty"`
+	Tags                  []CheckTag               `json:"tags,omitempty"`
+	Thresholds            []CheckThreshold         `json:"thresholds,omitempty"`
+	Level                 *domain.CheckStatusLevel `json:"level,omitempty"`
+	TimeSince             *string                  `json:"timeSince,omitempty"`
+	StaleTime             *string                  `json:"staleTime,omitempty"`
+	ReportZero            *bool                    `json:"reportZero,omitempty"`
+}
+
+// CheckTag is a tag written to each status of a check.
+type CheckTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CheckThreshold is the union of greater, lesser and range threshold models
+// of InfluxDB API.
+type CheckThreshold struct {
+	Type      string                   `json:"type"`
+	Level     *domain.CheckStatusLevel `json:"level,omitempty"`
+	AllValues *bool                    `json:"allValues,omitempty"`
+	Value     *float64                 `json:"value,omitempty"`
+	Min       *float64                 `json:"min,omitempty"`
+	Max       *float64                 `json:"max,omitempty"`
+	Within    *bool                    `json:"within,omitempty"`
+}
+
+// ChecksAPI is the set of calls we make in controllers that use Checks API.
+type ChecksAPI interface {
+	// GetCheckByID returns the check with the given ID.
+	GetCheckByID(ctx context.Context, checkID string) (*Check, error)
+
+	// CreateCheck creates a new check.
+	CreateCheck(ctx context.Context, check *Check) (*Check, error)
+
+	// UpdateCheck replaces the check that has the ID of the given check.
+	UpdateCheck(ctx context.Context, check *Check) (*Check, error)
+
+	// DeleteCheckWithID deletes the check with the given ID.
+	DeleteCheckWithID(ctx context.Context, checkID string) error
+}
+
+// NewChecksAPI returns a ChecksAPI that uses the given client. The generated
+// client cannot decode checks, so the request and response bodies are handled
+// here.
+func NewChecksAPI(c *domain.ClientWithResponses) ChecksAPI {
+	return &checksAPI{client: c}
+}
+
+type checksAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *checksAPI) GetCheckByID(ctx context.Context, checkID string) (*Check, error) {
+	resp, err := c.client.GetChecksIDWithResponse(ctx, checkID, &domain.GetChecksIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeCheck(resp.Body)
+}
+
+func (c *checksAPI) CreateCheck(ctx context.Context, check *Check) (*Check, error) {
+	b, err := json.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CreateCheckWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeCheck(resp.Body)
+}
+
+func (c *checksAPI) UpdateCheck(ctx context.Context, check *Check) (*Check, error) {
+	b, err := json.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PutChecksIDWithBodyWithResponse(ctx, *check.Id, &domain.PutChecksIDParams{}, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON404 != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeCheck(resp.Body)
+}
+
+func (c *checksAPI) DeleteCheckWithID(ctx context.Context, checkID string) error {
+	resp, err := c.client.DeleteChecksIDWithResponse(ctx, checkID, &domain.DeleteChecksIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSON404 != nil {
+		return domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func decodeCheck(body []byte) (*Check, error) {
+	out := &Check{}
+	return out, json.Unmarshal(body, out)
+}
+
+// MockChecksAPI mocks ChecksAPI.
+type MockChecksAPI struct {
+	GetCheckByIDFn      func(ctx context.Context, checkID string) (*Check, error)
+	CreateCheckFn       func(ctx context.Context, check *Check) (*Check, error)
+	UpdateCheckFn       func(ctx context.Context, check *Check) (*Check, error)
+	DeleteCheckWithIDFn func(ctx context.Context, checkID string) error
+}
+
+// GetCheckByID calls GetCheckByIDFn.
+func (m *MockChecksAPI) GetCheckByID(ctx context.Context, checkID string) (*Check, error) {
+	return m.GetCheckByIDFn(ctx, checkID)
+}
+
+// CreateCheck calls CreateCheckFn.
+func (m *MockChecksAPI) CreateCheck(ctx context.Context, check *Check) (*Check, error) {
+	return m.CreateCheckFn(ctx, check)
+}
+
+// UpdateCheck calls UpdateCheckFn.
+func (m *MockChecksAPI) UpdateCheck(ctx context.Context, check *Check) (*Check, error) {
+	return m.UpdateCheckFn(ctx, check)
+}
+
+// DeleteCheckWithID calls DeleteCheckWithIDFn.
+func (m *MockChecksAPI) DeleteCheckWithID(ctx context.Context, checkID string) error {
+	return m.DeleteCheckWithIDFn(ctx, checkID)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// DashboardsAPI is the set of calls we make in controllers that use
+// Dashboards API.
+type DashboardsAPI interface {
+	// GetDashboardByID returns the dashboard with the given ID including its
+	// cells.
+	GetDashboardByID(ctx context.Context, dashboardID string) (*domain.Dashboard, error)
+
+	// CreateDashboard creates a new dashboard without any cells.
+	CreateDashboard(ctx context.Context, dashboard *domain.CreateDashboardRequest) (*domain.Dashboard, error)
+
+	// UpdateDashboard updates the name and description of the dashboard with
+	// the given ID.
+	UpdateDashboard(ctx context.Context, dashboardID string, name string, description *string) error
+
+	// DeleteDashboardWithID deletes the dashboard with the given ID.
+	DeleteDashboardWithID(ctx context.Context, dashboardID string) error
+
+	// CreateCell creates a new cell in the dashboard with the given ID.
+	CreateCell(ctx context.Context, dashboardID string, cell domain.CreateCell) (*domain.Cell, error)
+
+	// UpdateCell updates the position and size of the given cell.
+	UpdateCell(ctx context.Context, dashboardID, cellID string, cell domain.CellUpdate) error
+
+	// GetCellView returns the view of the given cell.
+	GetCellView(ctx context.Context, dashboardID, cellID string) (*domain.View, error)
+
+	// UpdateCellView replaces the view of the given cell.
+	UpdateCellView(ctx context.Context, dashboardID, cellID string, view domain.View) error
+
+	// DeleteCell deletes the given cell.
+	DeleteCell(ctx context.Context, dashboardID, cellID string) error
+}
+
+// NewDashboardsAPI returns a DashboardsAPI that uses the given client.
+func NewDashboardsAPI(c *domain.ClientWithResponses) DashboardsAPI {
+	return &dashboardsAPI{client: c}
+}
+
+type dashboardsAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *dashboardsAPI) GetDashboardByID(ctx context.Context, dashboardID string) (*domain.Dashboard, error) {
+	resp, err := c.client.GetDashboardsIDWithResponse(ctx, dashboardID, &domain.GetDashboardsIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON404 != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	// The generated client decodes the dashboard into an empty interface.
+	out := &domain.Dashboard{}
+	return out, json.Unmarshal(resp.Body, out)
+}
+
+func (c *dashboardsAPI) CreateDashboard(ctx context.Context, dashboard *domain.CreateDashboardRequest) (*domain.Dashboard, error) {
+	resp, err := c.client.PostDashboardsWithResponse(ctx, &domain.PostDashboardsParams{}, domain.PostDashboardsJSONRequestBody(*dashboard))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	out := &domain.Dashboard{}
+	return out, json.Unmarshal(resp.Body, out)
+}
+
+func (c *dashboardsAPI) UpdateDashboard(ctx context.Context, dashboardID string, name string, description *string) error {
+	resp, err := c.client.PatchDashboardsIDWithResponse(ctx, dashboardID, &domain.PatchDashboardsIDParams{}, domain.PatchDashboardsIDJSONRequestBody{
+		Name:        &name,
+		Description: description,
+	})
+	if err != nil {
+		return err
+	}
+	return responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode())
+}
+
+func (c *dashboardsAPI) DeleteDashboardWithID(ctx context.Context, dashboardID string) error {
+	resp, err := c.client.DeleteDashboardsIDWithResponse(ctx, dashboardID, &domain.DeleteDashboardsIDParams{})
+	if err != nil {
+		return err
+	}
+	return responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode())
+}
+
+func (c *dashboardsAPI) CreateCell(ctx context.Context, dashboardID string, cell domain.CreateCell) (*domain.Cell, error) {
+	resp, err := c.client.PostDashboardsIDCellsWithResponse(ctx, dashboardID, &domain.PostDashboardsIDCellsParams{}, domain.PostDashboardsIDCellsJSONRequestBody(cell))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode()); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+func (c *dashboardsAPI) UpdateCell(ctx context.Context, dashboardID, cellID string, cell domain.CellUpdate) error {
+	resp, err := c.client.PatchDashboardsIDCellsIDWithResponse(ctx, dashboardID, cellID, &domain.PatchDashboardsIDCellsIDParams{}, domain.PatchDashboardsIDCellsIDJSONRequestBody(cell))
+	if err != nil {
+		return err
+	}
+	return responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode())
+}
+
+func (c *dashboardsAPI) GetCellView(ctx context.Context, dashboardID, cellID string) (*domain.View, error) {
+	resp, err := c.client.GetDashboardsIDCellsIDViewWithResponse(ctx, dashboardID, cellID, &domain.GetDashboardsIDCellsIDViewParams{})
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode()); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *dashboardsAPI) UpdateCellView(ctx context.Context, dashboardID, cellID string, view domain.View) error {
+	resp, err := c.client.PatchDashboardsIDCellsIDViewWithResponse(ctx, dashboardID, cellID, &domain.PatchDashboardsIDCellsIDViewParams{}, domain.PatchDashboardsIDCellsIDViewJSONRequestBody(view))
+	if err != nil {
+		return err
+	}
+	return responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode())
+}
+
+func (c *dashboardsAPI) DeleteCell(ctx context.Context, dashboardID, cellID string) error {
+	resp, err := c.client.DeleteDashboardsIDCellsIDWithResponse(ctx, dashboardID, cellID, &domain.DeleteDashboardsIDCellsIDParams{})
+	if err != nil {
+		return err
+	}
+	return responseError(resp.JSON404, resp.JSONDefault, resp.StatusCode())
+}
+
+// responseError returns the error in the given response fields, if any.
+func responseError(notFound, def *domain.Error, code int) error {
+	if notFound != nil {
+		return domain.ErrorToHTTPError(notFound, http.StatusNotFound)
+	}
+	if def != nil {
+		return domain.ErrorToHTTPError(def, code)
+	}
+	return nil
+}
+
+// MockDashboardsAPI mocks DashboardsAPI.
+type MockDashboardsAPI struct {
+	GetDashboardByIDFn      func(ctx context.Context, dashboardID string) (*domain.Dashboard, error)
+	CreateDashboardFn       func(ctx context.Context, dashboard *domain.CreateDashboardRequest) (*domain.Dashboard, error)
+	UpdateDashboardFn       func(ctx context.Context, dashboardID string, name string, description *string) error
+	DeleteDashboardWithIDFn func(ctx context.Context, dashboardID string) error
+	CreateCellFn            func(ctx context.Context, dashboardID string, cell domain.CreateCell) (*domain.Cell, error)
+	UpdateCellFn            func(ctx context.Context, dashboardID, cellID string, cell domain.CellUpdate) error
+	GetCellViewFn           func(ctx context.Context, dashboardID, cellID string) (*domain.View, error)
+	UpdateCellViewFn        func(ctx context.Context, dashboardID, cellID string, view domain.View) error
+	DeleteCellFn            func(ctx context.Context, dashboardID, cellID string) error
+}
+
+// GetDashboardByID calls GetDashboardByIDFn.
+func (m *MockDashboardsAPI) GetDashboardByID(ctx context.Context, dashboardID string) (*domain.Dashboard, error) {
+	return m.GetDashboardByIDFn(ctx, dashboardID)
+}
+
+// CreateDashboard calls CreateDashboardFn.
+func (m *MockDashboardsAPI) CreateDashboard(ctx context.Context, dashboard *domain.CreateDashboardRequest) (*domain.Dashboard, error) {
+	return m.CreateDashboardFn(ctx, dashboard)
+}
+
+// UpdateDashboard calls UpdateDashboardFn.
+func (m *MockDashboardsAPI) UpdateDashboard(ctx context.Context, dashboardID string, name string, description *string) error {
+	return m.UpdateDashboardFn(ctx, dashboardID, name, description)
+}
+
+// DeleteDashboardWithID calls DeleteDashboardWithIDFn.
+func (m *MockDashboardsAPI) DeleteDashboardWithID(ctx context.Context, dashboardID string) error {
+	return m.DeleteDashboardWithIDFn(ctx, dashboardID)
+}
+
+// CreateCell calls CreateCellFn.
+func (m *MockDashboardsAPI) CreateCell(ctx context.Context, dashboardID string, cell domain.CreateCell) (*domain.Cell, error) {
+	return m.CreateCellFn(ctx, dashboardID, cell)
+}
+
+// UpdateCell calls UpdateCellFn.
+func (m *MockDashboardsAPI) UpdateCell(ctx context.Context, dashboardID, cellID string, cell domain.CellUpdate) error {
+	return m.UpdateCellFn(ctx, dashboardID, cellID, cell)
+}
+
+// GetCellView calls GetCellViewFn.
+func (m *MockDashboardsAPI) GetCellView(ctx context.Context, dashboardID, cellID string) (*domain.View, error) {
+	return m.GetCellViewFn(ctx, dashboardID, cellID)
+}
+
+// UpdateCellView calls UpdateCellViewFn.
+func (m *MockDashboardsAPI) UpdateCellView(ctx context.Context, dashboardID, cellID string, view domain.View) error {
+	return m.UpdateCellViewFn(ctx, dashboardID, cellID, view)
+}
+
+// DeleteCell calls DeleteCellFn.
+func (m *MockDashboardsAPI) DeleteCell(ctx context.Context, dashboardID, cellID string) error {
+	return m.DeleteCellFn(ctx, dashboardID, cellID)
+}
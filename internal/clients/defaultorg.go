@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	errPaveManaged    = "cannot convert managed resource to unstructured"
+	errSetDefaultOrg  = "cannot set the ID of the default organization"
+	errFindDefaultOrg = "cannot find default organization"
+	errUpdateManaged  = "cannot update managed resource"
+
+	fieldOrgID         = "spec.forProvider.orgID"
+	fieldOrgIDRef      = "spec.forProvider.orgIDRef"
+	fieldOrgIDSelector = "spec.forProvider.orgIDSelector"
+)
+
+// A DefaultOrgInitializer sets spec.forProvider.orgID of the managed resources
+// that specify neither an organization nor a reference to one to the ID of
+// the default organization of their ProviderConfig. The ID is looked up once
+// and stored in the spec, so it is visible to users and all calls use it.
+type DefaultOrgInitializer struct {
+	kube    client.Client
+	newOrgs func(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (OrganizationsAPI, error)
+}
+
+// NewDefaultOrgInitializer returns a new DefaultOrgInitializer.
+func NewDefaultOrgInitializer(kube client.Client) *DefaultOrgInitializer {
+	return &DefaultOrgInitializer{
+		kube: kube,
+		newOrgs: func(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (OrganizationsAPI, error) {
+			cl, err := NewProviderConfigClient(ctx, kube, pc)
+			if err != nil {
+				return nil, err
+			}
+			return cl.OrganizationsAPI(), nil
+		},
+	}
+}
+
+// Initialize sets the ID of the default organization if the managed resource
+// needs it.
+func (i *DefaultOrgInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.WasDeleted(mg) {
+		return nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveManaged)
+	}
+	// NOTE: The references are resolved after the initializers run, so the
+	// ID may be missing because it is not resolved yet.
+	for _, f := range []string{fieldOrgID, fieldOrgIDRef, fieldOrgIDSelector} {
+		if _, err := p.GetValue(f); err == nil {
+			return nil
+		}
+	}
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := i.kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+	if pc.Spec.DefaultOrganization == nil {
+		return nil
+	}
+	orgs, err := i.newOrgs(ctx, i.kube, pc)
+	if err != nil {
+		return err
+	}
+	org, err := FindOrganization(ctx, orgs, *pc.Spec.DefaultOrganization)
+	if err != nil {
+		return errors.Wrap(err, errFindDefaultOrg)
+	}
+	if org.Id == nil {
+		return errors.New(errFindDefaultOrg)
+	}
+	if err := p.SetString(fieldOrgID, *org.Id); err != nil {
+		return errors.Wrap(err, errSetDefaultOrg)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(p.UnstructuredContent(), mg); err != nil {
+		return errors.Wrap(err, errSetDefaultOrg)
+	}
+	return errors.Wrap(i.kube.Update(ctx, mg), errUpdateManaged)
+}
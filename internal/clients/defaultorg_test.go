@@ -0,0 +1,326 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// providerConfig returns a client that returns a ProviderConfig with the given
+// default organization and stores the updated managed resource in updated.
+func providerConfig(defaultOrg *string, updated *client.Object) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*v1alpha1.ProviderConfig).Spec.DefaultOrganization = defaultOrg
+			return nil
+		},
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			*updated = obj
+			return nil
+		},
+	}
+}
+
+func TestDefaultOrgInitializer(t *testing.T) {
+	orgs := &MockOrganizationsAPI{
+		FindOrganizationByNameFn: func(_ context.Context, name string) (*domain.Organization, error) {
+			if name != "myorg" {
+				return nil, errBoom
+			}
+			return &domain.Organization{Id: pointer.String("0123456789abcdef"), Name: name}, nil
+		},
+	}
+
+	type args struct {
+		mg         *v1alpha1.Task
+		defaultOrg *string
+		orgs       OrganizationsAPI
+		getErr     error
+	}
+	type want struct {
+		mg      *v1alpha1.Task
+		updated bool
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"DefaultOrganization": {
+			reason: "We should set the ID of the default organization if no organization is given.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				defaultOrg: pointer.String("myorg"),
+				orgs:       orgs,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("0123456789abcdef"),
+							Flux:  pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				updated: true,
+			},
+		},
+		"OrgIDGiven": {
+			reason: "We should not override the given organization.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("own"),
+							Flux:  pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				defaultOrg: pointer.String("myorg"),
+				orgs:       orgs,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("own"),
+							Flux:  pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+			},
+		},
+		"ReferenceGiven": {
+			reason: "We should not set the organization if it is resolved from a reference later.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							OrgIDRef: &xpv1.Reference{Name: "org"},
+							Flux:     pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				defaultOrg: pointer.String("myorg"),
+				orgs:       orgs,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							OrgIDRef: &xpv1.Reference{Name: "org"},
+							Flux:     pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+			},
+		},
+		"NoDefaultOrganization": {
+			reason: "We should leave the organization empty if the ProviderConfig has no default one.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+			},
+		},
+		"Deleted": {
+			reason: "We should not look up the default organization of a deleted resource.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "downsample",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				getErr: errBoom,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "downsample",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+			},
+		},
+		"GetProviderConfigFailed": {
+			reason: "We should return the error if the ProviderConfig cannot be read.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				getErr: errBoom,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetPC),
+			},
+		},
+		"FindDefaultOrganizationFailed": {
+			reason: "We should return the error if the default organization cannot be found.",
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				defaultOrg: pointer.String("other"),
+				orgs:       orgs,
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+						ForProvider: v1alpha1.TaskParameters{
+							Flux: pointer.String("from(bucket: \"b\")"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errFindDefaultOrg),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated client.Object
+			kube := providerConfig(tc.args.defaultOrg, &updated)
+			if tc.args.getErr != nil {
+				kube.(*test.MockClient).MockGet = test.NewMockGetFn(tc.args.getErr)
+			}
+			i := &DefaultOrgInitializer{
+				kube: kube,
+				newOrgs: func(_ context.Context, _ client.Client, _ *v1alpha1.ProviderConfig) (OrganizationsAPI, error) {
+					return tc.args.orgs, nil
+				},
+			}
+			err := i.Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated != nil); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// TaskOptionLines is the number of lines that GenerateTaskFlux adds before the
+// script.
+const TaskOptionLines = 2
+
+// taskOptionRegex matches the beginning of the task option statement at the
+// beginning of a Flux script up to its opening brace.
+var taskOptionRegex = regexp.MustCompile(`^\s*option\s+task\s*=\s*\{`)
+
+// FluxAnalyzeAPI is the set of calls we make in controllers to validate Flux
+// scripts before they are sent to InfluxDB.
+type FluxAnalyzeAPI interface {
+	PostQueryAnalyzeWithResponse(ctx context.Context, params *domain.PostQueryAnalyzeParams, body domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error)
+}
+
+// MockFluxAnalyzeAPI mocks FluxAnalyzeAPI.
+type MockFluxAnalyzeAPI struct {
+	PostQueryAnalyzeWithResponseFn func(ctx context.Context, params *domain.PostQueryAnalyzeParams, body domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error)
+}
+
+// PostQueryAnalyzeWithResponse calls PostQueryAnalyzeWithResponseFn.
+func (m *MockFluxAnalyzeAPI) PostQueryAnalyzeWithResponse(ctx context.Context, params *domain.PostQueryAnalyzeParams, body domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error) {
+	return m.PostQueryAnalyzeWithResponseFn(ctx, params, body)
+}
+
+// TaskOptions are the fields of the task option statement of a Flux script.
+type TaskOptions struct {
+	Name   string
+	Every  *string
+	Cron   *string
+	Offset *string
+}
+
+// GenerateTaskOptions returns the task option statement for the given
+// options.
+func GenerateTaskOptions(o TaskOptions) string {
+	opts := []string{"name: " + fluxString(o.Name)}
+	switch {
+	case o.Every != nil:
+		opts = append(opts, "every: "+*o.Every)
+	case o.Cron != nil:
+		opts = append(opts, "cron: "+fluxString(*o.Cron))
+	}
+	if o.Offset != nil {
+		opts = append(opts, "offset: "+*o.Offset)
+	}
+	return fmt.Sprintf("option task = {%s}", strings.Join(opts, ", "))
+}
+
+// fluxStringEscaper escapes the characters that have a special meaning inside
+// a Flux string literal.
+var fluxStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", `\${`)
+
+// fluxString returns the given value as a Flux string literal.
+func fluxString(s string) string {
+	return `"` + fluxStringEscaper.Replace(s) + `"`
+}
+
+// GenerateTaskFlux returns the complete Flux script including its task option
+// statement.
+func GenerateTaskFlux(o TaskOptions, script string) string {
+	return GenerateTaskOptions(o) + strings.Repeat("\n", TaskOptionLines) + script
+}
+
+// StripTaskOptions returns the given Flux script without its task option
+// statement.
+func StripTaskOptions(flux string) string {
+	loc := taskOptionRegex.FindStringIndex(flux)
+	if loc == nil {
+		return strings.TrimSpace(flux)
+	}
+	end := closingBrace(flux, loc[1])
+	if end == -1 {
+		return strings.TrimSpace(flux)
+	}
+	return strings.TrimSpace(flux[end+1:])
+}
+
+// closingBrace returns the index of the brace that closes the record starting
+// at i, skipping string literals and comments, or -1 if it is not closed.
+func closingBrace(flux string, i int) int {
+	depth := 1
+	for ; i < len(flux); i++ {
+		switch flux[i] {
+		case '"':
+			for i++; i < len(flux) && flux[i] != '"'; i++ {
+				if flux[i] == '\\' {
+					i++
+				}
+			}
+		case '/':
+			if i+1 < len(flux) && flux[i+1] == '/' {
+				for i < len(flux) && flux[i] != '\n' {
+					i++
+				}
+			}
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
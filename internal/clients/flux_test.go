@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+)
+
+func TestGenerateTaskOptions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      TaskOptions
+		want   string
+	}{
+		"Every": {
+			reason: "We should generate the every and offset options as durations.",
+			o:      TaskOptions{Name: "t", Every: pointer.String("1h"), Offset: pointer.String("5m")},
+			want:   `option task = {name: "t", every: 1h, offset: 5m}`,
+		},
+		"Cron": {
+			reason: "We should generate the cron option as a string.",
+			o:      TaskOptions{Name: "t", Cron: pointer.String("0 * * * *")},
+			want:   `option task = {name: "t", cron: "0 * * * *"}`,
+		},
+		"Escaped": {
+			reason: "We should escape the special characters of the name and cron options.",
+			o:      TaskOptions{Name: `a "b" \ ${c}`, Cron: pointer.String(`0 * * * *", every: 1h, x: "`)},
+			want:   `option task = {name: "a \"b\" \\ \${c}", cron: "0 * * * *\", every: 1h, x: \""}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateTaskOptions(tc.o)); diff != "" {
+				t.Errorf("\n%s\nGenerateTaskOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestStripTaskOptions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		flux   string
+		want   string
+	}{
+		"NoOptions": {
+			reason: "We should return the script as is if it has no task option statement.",
+			flux:   "from(bucket: \"b\")\n",
+			want:   "from(bucket: \"b\")",
+		},
+		"Options": {
+			reason: "We should remove the task option statement.",
+			flux:   "option task = {name: \"t\", every: 1h}\n\nfrom(bucket: \"b\")",
+			want:   "from(bucket: \"b\")",
+		},
+		"BraceInString": {
+			reason: "We should not stop at a brace inside a string literal.",
+			flux:   "option task = {name: \"a} \\\"b}\\\"\", every: 1h}\n\nfrom(bucket: \"b\")",
+			want:   "from(bucket: \"b\")",
+		},
+		"BraceInComment": {
+			reason: "We should not stop at a brace inside a comment.",
+			flux:   "option task = {\n  // }\n  name: \"t\",\n  every: 1h,\n}\n\nfrom(bucket: \"b\")",
+			want:   "from(bucket: \"b\")",
+		},
+		"NestedRecord": {
+			reason: "We should match the brace that closes the task option record.",
+			flux:   "option task = {name: \"t\", every: 1h, r: {a: 1}}\n\nfrom(bucket: \"b\")",
+			want:   "from(bucket: \"b\")",
+		},
+		"NotClosed": {
+			reason: "We should return the script as is if the task option record is not closed.",
+			flux:   "option task = {name: \"t\"",
+			want:   "option task = {name: \"t\"",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, StripTaskOptions(tc.flux)); diff != "" {
+				t.Errorf("\n%s\nStripTaskOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
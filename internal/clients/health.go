@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Headers InfluxDB reports its build and version in.
+const (
+	headerBuild   = "X-Influxdb-Build"
+	headerVersion = "X-Influxdb-Version"
+)
+
+// Health is the health of an InfluxDB instance along with its build.
+type Health struct {
+	domain.HealthCheck
+
+	// Build of the instance, e.g. OSS or Cloud.
+	Build string
+}
+
+// HealthAPI is the set of calls we make to check whether an InfluxDB instance
+// can be used.
+type HealthAPI interface {
+	// GetHealth returns the health of the instance.
+	GetHealth(ctx context.Context) (*Health, error)
+
+	// GetReady returns whether the instance is ready to accept requests.
+	GetReady(ctx context.Context) (*domain.Ready, error)
+
+	// CheckAuthorization returns an error if the instance does not accept
+	// the credentials.
+	CheckAuthorization(ctx context.Context) error
+}
+
+// NewHealthAPI returns a HealthAPI that uses the given HTTP service.
+func NewHealthAPI(s apihttp.Service) HealthAPI {
+	return &healthAPI{service: s}
+}
+
+type healthAPI struct {
+	service apihttp.Service
+}
+
+func (c *healthAPI) GetHealth(ctx context.Context) (*Health, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.service.ServerURL()+"health", nil)
+	if err != nil {
+		return nil, err
+	}
+	out := &Health{}
+	if err := c.service.DoHTTPRequest(req, nil, func(resp *http.Response) error {
+		defer resp.Body.Close() // nolint:errcheck
+		out.Build = resp.Header.Get(headerBuild)
+		if err := json.NewDecoder(resp.Body).Decode(&out.HealthCheck); err != nil {
+			return err
+		}
+		// Older versions report the version only in the headers.
+		if out.Version == nil && resp.Header.Get(headerVersion) != "" {
+			v := resp.Header.Get(headerVersion)
+			out.Version = &v
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAPI) GetReady(ctx context.Context) (*domain.Ready, error) {
+	out := &domain.Ready{}
+	if err := doRequestURL(ctx, c.service, http.MethodGet, c.service.ServerURL()+"ready", nil, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NOTE: /health and /ready do not require authentication, so a cheap call
+// that does is made to check the credentials. InfluxDB filters the result by
+// the permissions of the token, so it succeeds for any valid token.
+func (c *healthAPI) CheckAuthorization(ctx context.Context) error {
+	return doRequest(ctx, c.service, http.MethodGet, "orgs?limit=1", nil, nil)
+}
+
+// MockHealthAPI mocks HealthAPI.
+type MockHealthAPI struct {
+	GetHealthFn          func(ctx context.Context) (*Health, error)
+	GetReadyFn           func(ctx context.Context) (*domain.Ready, error)
+	CheckAuthorizationFn func(ctx context.Context) error
+}
+
+// GetHealth calls GetHealthFn.
+func (m *MockHealthAPI) GetHealth(ctx context.Context) (*Health, error) {
+	return m.GetHealthFn(ctx)
+}
+
+// GetReady calls GetReadyFn.
+func (m *MockHealthAPI) GetReady(ctx context.Context) (*domain.Ready, error) {
+	return m.GetReadyFn(ctx)
+}
+
+// CheckAuthorization calls CheckAuthorizationFn.
+func (m *MockHealthAPI) CheckAuthorization(ctx context.Context) error {
+	return m.CheckAuthorizationFn(ctx)
+}
@@ -17,17 +17,23 @@ limitations under the License.
 package clients
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	influxdbv2 "github.com/influxdata/influxdb-client-go/v2"
 	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
@@ -37,43 +43,86 @@ const (
 	errTrackPCUsage = "cannot track ProviderConfig usage"
 	errGetPC        = "cannot get referenced ProviderConfig"
 	errGetCreds     = "cannot get credentials"
+
+	errNoCreds        = "credentials are required in Token mode"
+	errNoSessionCreds = "usernameSecretRef and passwordSecretRef are required in Session mode"
+	errGetUsername    = "cannot get username"
+	errGetPassword    = "cannot get password"
 )
 
 // NewClient returns the base InfluxDB client.
 func NewClient(ctx context.Context, kube client.Client, mg resource.Managed) (influxdbv2.Client, error) {
-	pc := &v1alpha1.ProviderConfig{}
-	if err := kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
+	pc, err := getProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
 	}
+	return NewProviderConfigClient(ctx, kube, pc)
+}
 
-	if err := resource.NewProviderConfigUsageTracker(kube, &v1alpha1.ProviderConfigUsage{}).Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+// NewProviderConfigClient returns the base InfluxDB client for the given
+// ProviderConfig. Unlike NewClient, it does not track the usage of the
+// ProviderConfig.
+func NewProviderConfigClient(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (influxdbv2.Client, error) {
+	rt, err := newTransport(ctx, kube, pc)
+	if err != nil {
+		return nil, err
 	}
+	token, hc, err := authenticate(ctx, kube, pc, rt)
+	if err != nil {
+		return nil, err
+	}
+	return influxdbv2.NewClientWithOptions(pc.Spec.Endpoint, token, influxdbv2.DefaultOptions().SetHTTPRequestTimeout(requestTimeout(pc)).SetHTTPClient(hc)), nil
+}
+
+// A ClientSet is the set of clients built from a single ProviderConfig.
+type ClientSet struct {
+	// Client is the base InfluxDB client.
+	Client influxdbv2.Client
+
+	// WithResponses is the bare client. It sends the requests through the
+	// same HTTP service as Client.
+	WithResponses *domain.ClientWithResponses
 
-	cd := pc.Spec.Credentials
-	token, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+	// DefaultOrganization is the name or ID of the default organization of
+	// the ProviderConfig. It is empty if the ProviderConfig does not have one.
+	DefaultOrganization string
+}
+
+// NewClientSet returns both the base and the bare InfluxDB clients for the
+// ProviderConfig that the given managed resource refers to. Use this instead
+// of calling NewClient and NewClientWithResponses so that the ProviderConfig
+// is loaded and its usage is tracked only once.
+func NewClientSet(ctx context.Context, kube client.Client, mg resource.Managed) (*ClientSet, error) {
+	pc, err := getProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := NewProviderConfigClient(ctx, kube, pc)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
+		return nil, err
 	}
-	return influxdbv2.NewClient(pc.Spec.Endpoint, string(token)), nil
+	return &ClientSet{
+		Client:              cl,
+		WithResponses:       domain.NewClientWithResponses(cl.HTTPService()),
+		DefaultOrganization: pointer.StringDeref(pc.Spec.DefaultOrganization, ""),
+	}, nil
 }
 
 // NewClientWithResponses returns the bare client. Use this only if NewClient
 // does not meet your needs.
 func NewClientWithResponses(ctx context.Context, kube client.Client, mg resource.Managed) (*domain.ClientWithResponses, error) {
-	pc := &v1alpha1.ProviderConfig{}
-	if err := kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
+	pc, err := getProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := resource.NewProviderConfigUsageTracker(kube, &v1alpha1.ProviderConfigUsage{}).Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+	rt, err := newTransport(ctx, kube, pc)
+	if err != nil {
+		return nil, err
 	}
-
-	cd := pc.Spec.Credentials
-	token, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+	token, hc, err := authenticate(ctx, kube, pc, rt)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
+		return nil, err
 	}
 	// NOTE(muvaf): The rest of this function is taken from the content of
 	// influxdbv2.NewClient.
@@ -83,15 +132,147 @@ func NewClientWithResponses(ctx context.Context, kube client.Client, mg resource
 		normServerURL = pc.Spec.Endpoint + "/"
 	}
 	authorization := ""
-	if len(string(token)) > 0 {
-		authorization = "Token " + string(token)
+	if len(token) > 0 {
+		authorization = "Token " + token
 	}
-	service := apihttp.NewService(normServerURL, authorization, apihttp.DefaultOptions())
+	service := apihttp.NewService(normServerURL, authorization, apihttp.DefaultOptions().SetHTTPRequestTimeout(requestTimeout(pc)).SetHTTPClient(hc))
 	return domain.NewClientWithResponses(service), nil
 }
 
+// NewOnboardingClient returns an InfluxDB client that does not authenticate.
+// Only the onboarding endpoints work without a token, and the credentials the
+// ProviderConfig refers to usually do not exist before the instance is
+// onboarded, so they are not read at all.
+func NewOnboardingClient(ctx context.Context, kube client.Client, mg resource.Managed) (influxdbv2.Client, error) {
+	pc, err := getProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := newTransport(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	timeout := requestTimeout(pc)
+	o := influxdbv2.DefaultOptions().SetHTTPRequestTimeout(timeout).SetHTTPClient(newHTTPClient(rt, timeout))
+	return influxdbv2.NewClientWithOptions(pc.Spec.Endpoint, "", o), nil
+}
+
+// authenticate returns the token and the HTTP client to use with the given
+// ProviderConfig. The token is empty in Session mode, where the HTTP client
+// signs in instead. The HTTP client sends the requests through the given
+// transport, and a nil HTTP client means the default one.
+func authenticate(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig, rt http.RoundTripper) (string, *http.Client, error) {
+	if pc.Spec.AuthMode != v1alpha1.AuthModeSession {
+		cd := pc.Spec.Credentials
+		if cd == nil {
+			return "", nil, errors.New(errNoCreds)
+		}
+		token, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+		if err != nil {
+			return "", nil, errors.Wrap(err, errGetCreds)
+		}
+		return string(token), newHTTPClient(rt, requestTimeout(pc)), nil
+	}
+
+	if pc.Spec.UsernameSecretRef == nil || pc.Spec.PasswordSecretRef == nil {
+		return "", nil, errors.New(errNoSessionCreds)
+	}
+	username, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: pc.Spec.UsernameSecretRef})
+	if err != nil {
+		return "", nil, errors.Wrap(err, errGetUsername)
+	}
+	password, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: pc.Spec.PasswordSecretRef})
+	if err != nil {
+		return "", nil, errors.Wrap(err, errGetPassword)
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return "", newHTTPClient(newSessionTransport(rt, pc.Spec.Endpoint, string(username), string(password)), requestTimeout(pc)), nil
+}
+
+// newHTTPClient returns an HTTP client that sends requests through the given
+// transport with the given timeout in seconds. It returns nil if the
+// transport is nil so that the InfluxDB client uses its default one.
+func newHTTPClient(rt http.RoundTripper, timeout uint) *http.Client {
+	if rt == nil {
+		return nil
+	}
+	return &http.Client{
+		Timeout:   time.Duration(timeout) * time.Second,
+		Transport: rt,
+	}
+}
+
+// getProviderConfig returns the ProviderConfig that the given managed resource
+// refers to and tracks its usage.
+func getProviderConfig(ctx context.Context, kube client.Client, mg resource.Managed) (*v1alpha1.ProviderConfig, error) {
+	pc := &v1alpha1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	if err := resource.NewProviderConfigUsageTracker(kube, &v1alpha1.ProviderConfigUsage{}).Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	return pc, nil
+}
+
 // IsNotFound returns whether the error is of type NotFound.
 func IsNotFound(err error) bool {
 	hErr, ok := err.(*apihttp.Error)
 	return ok && hErr.StatusCode == http.StatusNotFound
 }
+
+// IsUnauthorized returns whether InfluxDB rejected the credentials, either
+// when a request is sent or when signing in.
+func IsUnauthorized(err error) bool {
+	hErr := &apihttp.Error{}
+	if !errors.As(err, &hErr) {
+		return false
+	}
+	// Errors of the transport, such as the ones of signing in, are wrapped
+	// into an error without a status code.
+	if hErr.StatusCode == 0 && hErr.Err != nil {
+		return IsUnauthorized(hErr.Err)
+	}
+	return hErr.StatusCode == http.StatusUnauthorized || hErr.StatusCode == http.StatusForbidden
+}
+
+// doRequest sends a request with the given body encoded as JSON to the given
+// path of the API and decodes the response into out unless it is nil. It is
+// used for the endpoints that the generated client does not support. Errors
+// are returned as *apihttp.Error so that IsNotFound works with them.
+func doRequest(ctx context.Context, s apihttp.Service, method, path string, in, out interface{}) error {
+	return doRequestURL(ctx, s, method, s.ServerAPIURL()+path, in, out)
+}
+
+// doRequestURL is the same as doRequest but takes the full URL. It is used for
+// the endpoints that are not served under the API path.
+func doRequestURL(ctx context.Context, s apihttp.Service, method, url string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if err := s.DoHTTPRequest(req, nil, func(resp *http.Response) error {
+		defer resp.Body.Close() // nolint:errcheck
+		if out == nil {
+			return nil
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
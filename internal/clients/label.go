@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// LabelsAPI is the set of calls we make in controllers that use Labels API.
+type LabelsAPI interface {
+	// CreateLabel creates a new label.
+	CreateLabel(ctx context.Context, label *domain.LabelCreateRequest) (*domain.Label, error)
+
+	// FindLabelByName returns a label found using orgID and labelName.
+	FindLabelByName(ctx context.Context, orgID, labelName string) (*domain.Label, error)
+
+	// UpdateLabel updates a label.
+	UpdateLabel(ctx context.Context, label *domain.Label) (*domain.Label, error)
+
+	// DeleteLabelWithID deletes the label with the given ID.
+	DeleteLabelWithID(ctx context.Context, labelID string) error
+}
+
+// MockLabelsAPI mocks LabelsAPI.
+type MockLabelsAPI struct {
+	CreateLabelFn       func(ctx context.Context, label *domain.LabelCreateRequest) (*domain.Label, error)
+	FindLabelByNameFn   func(ctx context.Context, orgID, labelName string) (*domain.Label, error)
+	UpdateLabelFn       func(ctx context.Context, label *domain.Label) (*domain.Label, error)
+	DeleteLabelWithIDFn func(ctx context.Context, labelID string) error
+}
+
+// CreateLabel calls CreateLabelFn.
+func (m *MockLabelsAPI) CreateLabel(ctx context.Context, label *domain.LabelCreateRequest) (*domain.Label, error) {
+	return m.CreateLabelFn(ctx, label)
+}
+
+// FindLabelByName calls FindLabelByNameFn.
+func (m *MockLabelsAPI) FindLabelByName(ctx context.Context, orgID, labelName string) (*domain.Label, error) {
+	return m.FindLabelByNameFn(ctx, orgID, labelName)
+}
+
+// UpdateLabel calls UpdateLabelFn.
+func (m *MockLabelsAPI) UpdateLabel(ctx context.Context, label *domain.Label) (*domain.Label, error) {
+	return m.UpdateLabelFn(ctx, label)
+}
+
+// DeleteLabelWithID calls DeleteLabelWithIDFn.
+func (m *MockLabelsAPI) DeleteLabelWithID(ctx context.Context, labelID string) error {
+	return m.DeleteLabelWithIDFn(ctx, labelID)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// LegacyAuthorizationsAPI is the set of calls we make in controllers that use
+// v1 compatible authorizations. The generated client sends these calls to
+// /legacy/authorizations, but InfluxDB serves them under
+// /private/legacy/authorizations.
+type LegacyAuthorizationsAPI interface {
+	// GetLegacyAuthorizationByID returns the legacy authorization with the
+	// given ID.
+	GetLegacyAuthorizationByID(ctx context.Context, id string) (*domain.Authorization, error)
+
+	// CreateLegacyAuthorization creates a new legacy authorization.
+	CreateLegacyAuthorization(ctx context.Context, req *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error)
+
+	// UpdateLegacyAuthorization updates the description and the status of the
+	// legacy authorization with the given ID.
+	UpdateLegacyAuthorization(ctx context.Context, id string, req *domain.AuthorizationUpdateRequest) (*domain.Authorization, error)
+
+	// SetLegacyAuthorizationPassword sets the password of the legacy
+	// authorization with the given ID.
+	SetLegacyAuthorizationPassword(ctx context.Context, id, password string) error
+
+	// DeleteLegacyAuthorizationWithID deletes the legacy authorization with the
+	// given ID.
+	DeleteLegacyAuthorizationWithID(ctx context.Context, id string) error
+}
+
+// NewLegacyAuthorizationsAPI returns a LegacyAuthorizationsAPI that uses the
+// given HTTP service.
+func NewLegacyAuthorizationsAPI(s apihttp.Service) LegacyAuthorizationsAPI {
+	return &legacyAuthorizationsAPI{service: s}
+}
+
+type legacyAuthorizationsAPI struct {
+	service apihttp.Service
+}
+
+func (c *legacyAuthorizationsAPI) GetLegacyAuthorizationByID(ctx context.Context, id string) (*domain.Authorization, error) {
+	out := &domain.Authorization{}
+	return out, doRequestURL(ctx, c.service, http.MethodGet, c.url(id), nil, out)
+}
+
+func (c *legacyAuthorizationsAPI) CreateLegacyAuthorization(ctx context.Context, req *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error) {
+	out := &domain.Authorization{}
+	return out, doRequestURL(ctx, c.service, http.MethodPost, c.url(""), req, out)
+}
+
+func (c *legacyAuthorizationsAPI) UpdateLegacyAuthorization(ctx context.Context, id string, req *domain.AuthorizationUpdateRequest) (*domain.Authorization, error) {
+	out := &domain.Authorization{}
+	return out, doRequestURL(ctx, c.service, http.MethodPatch, c.url(id), req, out)
+}
+
+func (c *legacyAuthorizationsAPI) SetLegacyAuthorizationPassword(ctx context.Context, id, password string) error {
+	return doRequestURL(ctx, c.service, http.MethodPost, c.url(id)+"/password", &domain.PasswordResetBody{Password: password}, nil)
+}
+
+func (c *legacyAuthorizationsAPI) DeleteLegacyAuthorizationWithID(ctx context.Context, id string) error {
+	return doRequestURL(ctx, c.service, http.MethodDelete, c.url(id), nil, nil)
+}
+
+func (c *legacyAuthorizationsAPI) url(id string) string {
+	u := c.service.ServerURL() + "private/legacy/authorizations"
+	if id != "" {
+		u += "/" + id
+	}
+	return u
+}
+
+// MockLegacyAuthorizationsAPI mocks LegacyAuthorizationsAPI.
+type MockLegacyAuthorizationsAPI struct {
+	GetLegacyAuthorizationByIDFn      func(ctx context.Context, id string) (*domain.Authorization, error)
+	CreateLegacyAuthorizationFn       func(ctx context.Context, req *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error)
+	UpdateLegacyAuthorizationFn       func(ctx context.Context, id string, req *domain.AuthorizationUpdateRequest) (*domain.Authorization, error)
+	SetLegacyAuthorizationPasswordFn  func(ctx context.Context, id, password string) error
+	DeleteLegacyAuthorizationWithIDFn func(ctx context.Context, id string) error
+}
+
+// GetLegacyAuthorizationByID calls GetLegacyAuthorizationByIDFn.
+func (m *MockLegacyAuthorizationsAPI) GetLegacyAuthorizationByID(ctx context.Context, id string) (*domain.Authorization, error) {
+	return m.GetLegacyAuthorizationByIDFn(ctx, id)
+}
+
+// CreateLegacyAuthorization calls CreateLegacyAuthorizationFn.
+func (m *MockLegacyAuthorizationsAPI) CreateLegacyAuthorization(ctx context.Context, req *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error) {
+	return m.CreateLegacyAuthorizationFn(ctx, req)
+}
+
+// UpdateLegacyAuthorization calls UpdateLegacyAuthorizationFn.
+func (m *MockLegacyAuthorizationsAPI) UpdateLegacyAuthorization(ctx context.Context, id string, req *domain.AuthorizationUpdateRequest) (*domain.Authorization, error) {
+	return m.UpdateLegacyAuthorizationFn(ctx, id, req)
+}
+
+// SetLegacyAuthorizationPassword calls SetLegacyAuthorizationPasswordFn.
+func (m *MockLegacyAuthorizationsAPI) SetLegacyAuthorizationPassword(ctx context.Context, id, password string) error {
+	return m.SetLegacyAuthorizationPasswordFn(ctx, id, password)
+}
+
+// DeleteLegacyAuthorizationWithID calls DeleteLegacyAuthorizationWithIDFn.
+func (m *MockLegacyAuthorizationsAPI) DeleteLegacyAuthorizationWithID(ctx context.Context, id string) error {
+	return m.DeleteLegacyAuthorizationWithIDFn(ctx, id)
+}
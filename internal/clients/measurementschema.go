@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+// MeasurementSchemaColumn is a column of a measurement schema.
+type MeasurementSchemaColumn struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	DataType *string `json:"dataType,omitempty"`
+}
+
+// MeasurementSchema is the schema of a measurement in a bucket with explicit
+// schema type.
+type MeasurementSchema struct {
+	ID        string                    `json:"id"`
+	OrgID     string                    `json:"orgID"`
+	BucketID  string                    `json:"bucketID"`
+	Name      string                    `json:"name"`
+	Columns   []MeasurementSchemaColumn `json:"columns"`
+	CreatedAt *time.Time                `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time                `json:"updatedAt,omitempty"`
+}
+
+// MeasurementSchemasAPI is the set of calls we make in controllers that use
+// Bucket Schema API, which the generated client does not support.
+type MeasurementSchemasAPI interface {
+	// GetMeasurementSchema returns the measurement schema with the given ID.
+	GetMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string) (*MeasurementSchema, error)
+
+	// CreateMeasurementSchema creates a new measurement schema in the bucket
+	// with the given ID.
+	CreateMeasurementSchema(ctx context.Context, orgID, bucketID, name string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error)
+
+	// UpdateMeasurementSchema replaces the columns of the measurement schema
+	// with the given ID. The API only accepts columns to be added.
+	UpdateMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error)
+}
+
+// NewMeasurementSchemasAPI returns a MeasurementSchemasAPI that uses the given
+// HTTP service.
+func NewMeasurementSchemasAPI(s apihttp.Service) MeasurementSchemasAPI {
+	return &measurementSchemasAPI{service: s}
+}
+
+type measurementSchemasAPI struct {
+	service apihttp.Service
+}
+
+func (c *measurementSchemasAPI) GetMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string) (*MeasurementSchema, error) {
+	out := &MeasurementSchema{}
+	return out, doRequest(ctx, c.service, http.MethodGet, measurementsPath(orgID, bucketID, measurementID), nil, out)
+}
+
+func (c *measurementSchemasAPI) CreateMeasurementSchema(ctx context.Context, orgID, bucketID, name string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error) {
+	body := struct {
+		Name    string                    `json:"name"`
+		Columns []MeasurementSchemaColumn `json:"columns"`
+	}{Name: name, Columns: columns}
+	out := &MeasurementSchema{}
+	return out, doRequest(ctx, c.service, http.MethodPost, measurementsPath(orgID, bucketID, ""), body, out)
+}
+
+func (c *measurementSchemasAPI) UpdateMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error) {
+	body := struct {
+		Columns []MeasurementSchemaColumn `json:"columns"`
+	}{Columns: columns}
+	out := &MeasurementSchema{}
+	return out, doRequest(ctx, c.service, http.MethodPatch, measurementsPath(orgID, bucketID, measurementID), body, out)
+}
+
+// measurementsPath returns the path of the measurement schema with the given
+// ID, or of all measurement schemas of the bucket if the ID is empty.
+func measurementsPath(orgID, bucketID, measurementID string) string {
+	p := "buckets/" + url.PathEscape(bucketID) + "/schema/measurements"
+	if measurementID != "" {
+		p += "/" + url.PathEscape(measurementID)
+	}
+	return p + "?" + url.Values{"orgID": []string{orgID}}.Encode()
+}
+
+// MockMeasurementSchemasAPI mocks MeasurementSchemasAPI.
+type MockMeasurementSchemasAPI struct {
+	GetMeasurementSchemaFn    func(ctx context.Context, orgID, bucketID, measurementID string) (*MeasurementSchema, error)
+	CreateMeasurementSchemaFn func(ctx context.Context, orgID, bucketID, name string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error)
+	UpdateMeasurementSchemaFn func(ctx context.Context, orgID, bucketID, measurementID string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error)
+}
+
+// GetMeasurementSchema calls GetMeasurementSchemaFn.
+func (m *MockMeasurementSchemasAPI) GetMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string) (*MeasurementSchema, error) {
+	return m.GetMeasurementSchemaFn(ctx, orgID, bucketID, measurementID)
+}
+
+// CreateMeasurementSchema calls CreateMeasurementSchemaFn.
+func (m *MockMeasurementSchemasAPI) CreateMeasurementSchema(ctx context.Context, orgID, bucketID, name string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error) {
+	return m.CreateMeasurementSchemaFn(ctx, orgID, bucketID, name, columns)
+}
+
+// UpdateMeasurementSchema calls UpdateMeasurementSchemaFn.
+func (m *MockMeasurementSchemasAPI) UpdateMeasurementSchema(ctx context.Context, orgID, bucketID, measurementID string, columns []MeasurementSchemaColumn) (*MeasurementSchema, error) {
+	return m.UpdateMeasurementSchemaFn(ctx, orgID, bucketID, measurementID, columns)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// NotificationEndpoint is the union of the http, slack and pagerduty
+// notification endpoint models of InfluxDB API. The generated
+// domain.NotificationEndpoint cannot be used for serialization since its
+// fields depend on its type.
+type NotificationEndpoint struct {
+	domain.NotificationEndpointBase
+
+	URL             *string           `json:"url,omitempty"`
+	Method          *string           `json:"method,omitempty"`
+	AuthMethod      *string           `json:"authMethod,omitempty"`
+	Username        *string           `json:"username,omitempty"`
+	Password        *string           `json:"password,omitempty"`
+	Token           *string           `json:"token,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	ContentTemplate *string           `json:"contentTemplate,omitempty"`
+	ClientURL       *string           `json:"clientURL,omitempty"`
+	RoutingKey      *string           `json:"routingKey,omitempty"`
+}
+
+// NotificationEndpointsAPI is the set of calls we make in controllers that use
+// Notification Endpoints API.
+type NotificationEndpointsAPI interface {
+	// GetNotificationEndpointByID returns the notification endpoint with the
+	// given ID.
+	GetNotificationEndpointByID(ctx context.Context, endpointID string) (*NotificationEndpoint, error)
+
+	// CreateNotificationEndpoint creates a new notification endpoint.
+	CreateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error)
+
+	// UpdateNotificationEndpoint replaces the notification endpoint that has
+	// the ID of the given notification endpoint.
+	UpdateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error)
+
+	// DeleteNotificationEndpointWithID deletes the notification endpoint with
+	// the given ID.
+	DeleteNotificationEndpointWithID(ctx context.Context, endpointID string) error
+}
+
+// NewNotificationEndpointsAPI returns a NotificationEndpointsAPI that uses the
+// given client. The generated client cannot decode notification endpoints,
+// so the request and response bodies are handled here.
+func NewNotificationEndpointsAPI(c *domain.ClientWithResponses) NotificationEndpointsAPI {
+	return &notificationEndpointsAPI{client: c}
+}
+
+type notificationEndpointsAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *notificationEndpointsAPI) GetNotificationEndpointByID(ctx context.Context, endpointID string) (*NotificationEndpoint, error) {
+	resp, err := c.client.GetNotificationEndpointsIDWithResponse(ctx, endpointID, &domain.GetNotificationEndpointsIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationEndpoint(resp.Body)
+}
+
+func (c *notificationEndpointsAPI) CreateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error) {
+	b, err := json.Marshal(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CreateNotificationEndpointWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationEndpoint(resp.Body)
+}
+
+func (c *notificationEndpointsAPI) UpdateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error) {
+	b, err := json.Marshal(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PutNotificationEndpointsIDWithBodyWithResponse(ctx, *endpoint.Id, &domain.PutNotificationEndpointsIDParams{}, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON404 != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationEndpoint(resp.Body)
+}
+
+func (c *notificationEndpointsAPI) DeleteNotificationEndpointWithID(ctx context.Context, endpointID string) error {
+	resp, err := c.client.DeleteNotificationEndpointsIDWithResponse(ctx, endpointID, &domain.DeleteNotificationEndpointsIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSON404 != nil {
+		return domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func decodeNotificationEndpoint(body []byte) (*NotificationEndpoint, error) {
+	out := &NotificationEndpoint{}
+	return out, json.Unmarshal(body, out)
+}
+
+// MockNotificationEndpointsAPI mocks NotificationEndpointsAPI.
+type MockNotificationEndpointsAPI struct {
+	GetNotificationEndpointByIDFn      func(ctx context.Context, endpointID string) (*NotificationEndpoint, error)
+	CreateNotificationEndpointFn       func(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error)
+	UpdateNotificationEndpointFn       func(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error)
+	DeleteNotificationEndpointWithIDFn func(ctx context.Context, endpointID string) error
+}
+
+// GetNotificationEndpointByID calls GetNotificationEndpointByIDFn.
+func (m *MockNotificationEndpointsAPI) GetNotificationEndpointByID(ctx context.Context, endpointID string) (*NotificationEndpoint, error) {
+	return m.GetNotificationEndpointByIDFn(ctx, endpointID)
+}
+
+// CreateNotificationEndpoint calls CreateNotificationEndpointFn.
+func (m *MockNotificationEndpointsAPI) CreateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error) {
+	return m.CreateNotificationEndpointFn(ctx, endpoint)
+}
+
+// UpdateNotificationEndpoint calls UpdateNotificationEndpointFn.
+func (m *MockNotificationEndpointsAPI) UpdateNotificationEndpoint(ctx context.Context, endpoint *NotificationEndpoint) (*NotificationEndpoint, error) {
+	return m.UpdateNotificationEndpointFn(ctx, endpoint)
+}
+
+// DeleteNotificationEndpointWithID calls DeleteNotificationEndpointWithIDFn.
+func (m *MockNotificationEndpointsAPI) DeleteNotificationEndpointWithID(ctx context.Context, endpointID string) error {
+	return m.DeleteNotificationEndpointWithIDFn(ctx, endpointID)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// NotificationRule is the union of the http, slack and pagerduty
+// notification rule models of InfluxDB API. The generated
+// domain.NotificationRule cannot be used for serialization since its fields
+// depend on its type.
+type NotificationRule struct {
+	domain.NotificationRuleBase
+
+	Type            string  `json:"type"`
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
+	Channel         *string `json:"channel,omitempty"`
+}
+
+// NotificationRulesAPI is the set of calls we make in controllers that use
+// Notification Rules API.
+type NotificationRulesAPI interface {
+	// GetNotificationRuleByID returns the notification rule with the given ID.
+	GetNotificationRuleByID(ctx context.Context, ruleID string) (*NotificationRule, error)
+
+	// CreateNotificationRule creates a new notification rule.
+	CreateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error)
+
+	// UpdateNotificationRule replaces the notification rule that has the ID
+	// of the given notification rule.
+	UpdateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error)
+
+	// DeleteNotificationRuleWithID deletes the notification rule with the
+	// given ID.
+	DeleteNotificationRuleWithID(ctx context.Context, ruleID string) error
+}
+
+// NewNotificationRulesAPI returns a NotificationRulesAPI that uses the given
+// client. The generated client cannot decode notification rules, so the
+// request and response bodies are handled here.
+func NewNotificationRulesAPI(c *domain.ClientWithResponses) NotificationRulesAPI {
+	return &notificationRulesAPI{client: c}
+}
+
+type notificationRulesAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *notificationRulesAPI) GetNotificationRuleByID(ctx context.Context, ruleID string) (*NotificationRule, error) {
+	resp, err := c.client.GetNotificationRulesIDWithResponse(ctx, ruleID, &domain.GetNotificationRulesIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationRule(resp.Body)
+}
+
+func (c *notificationRulesAPI) CreateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error) {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CreateNotificationRuleWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationRule(resp.Body)
+}
+
+func (c *notificationRulesAPI) UpdateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error) {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PutNotificationRulesIDWithBodyWithResponse(ctx, *rule.Id, &domain.PutNotificationRulesIDParams{}, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON404 != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeNotificationRule(resp.Body)
+}
+
+func (c *notificationRulesAPI) DeleteNotificationRuleWithID(ctx context.Context, ruleID string) error {
+	resp, err := c.client.DeleteNotificationRulesIDWithResponse(ctx, ruleID, &domain.DeleteNotificationRulesIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSON404 != nil {
+		return domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func decodeNotificationRule(body []byte) (*NotificationRule, error) {
+	out := &NotificationRule{}
+	return out, json.Unmarshal(body, out)
+}
+
+// MockNotificationRulesAPI mocks NotificationRulesAPI.
+type MockNotificationRulesAPI struct {
+	GetNotificationRuleByIDFn      func(ctx context.Context, ruleID string) (*NotificationRule, error)
+	CreateNotificationRuleFn       func(ctx context.Context, rule *NotificationRule) (*NotificationRule, error)
+	UpdateNotificationRuleFn       func(ctx context.Context, rule *NotificationRule) (*NotificationRule, error)
+	DeleteNotificationRuleWithIDFn func(ctx context.Context, ruleID string) error
+}
+
+// GetNotificationRuleByID calls GetNotificationRuleByIDFn.
+func (m *MockNotificationRulesAPI) GetNotificationRuleByID(ctx context.Context, ruleID string) (*NotificationRule, error) {
+	return m.GetNotificationRuleByIDFn(ctx, ruleID)
+}
+
+// CreateNotificationRule calls CreateNotificationRuleFn.
+func (m *MockNotificationRulesAPI) CreateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error) {
+	return m.CreateNotificationRuleFn(ctx, rule)
+}
+
+// UpdateNotificationRule calls UpdateNotificationRuleFn.
+func (m *MockNotificationRulesAPI) UpdateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error) {
+	return m.UpdateNotificationRuleFn(ctx, rule)
+}
+
+// DeleteNotificationRuleWithID calls DeleteNotificationRuleWithIDFn.
+func (m *MockNotificationRulesAPI) DeleteNotificationRuleWithID(ctx context.Context, ruleID string) error {
+	return m.DeleteNotificationRuleWithIDFn(ctx, ruleID)
+}
@@ -18,10 +18,14 @@ package clients
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
+// idRegex matches the IDs that InfluxDB generates for its resources.
+var idRegex = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
 // OrganizationsAPI is the set of calls we make in controllers that use Organizations
 // API.
 type OrganizationsAPI interface {
@@ -31,6 +35,9 @@ type OrganizationsAPI interface {
 	// FindOrganizationByName returns an organization found using orgName.
 	FindOrganizationByName(ctx context.Context, orgName string) (*domain.Organization, error)
 
+	// FindOrganizationByID returns an organization found using orgID.
+	FindOrganizationByID(ctx context.Context, orgID string) (*domain.Organization, error)
+
 	// UpdateOrganization updates organization.
 	UpdateOrganization(ctx context.Context, org *domain.Organization) (*domain.Organization, error)
 
@@ -42,6 +49,7 @@ type OrganizationsAPI interface {
 type MockOrganizationsAPI struct {
 	CreateOrganizationFn     func(ctx context.Context, org *domain.Organization) (*domain.Organization, error)
 	FindOrganizationByNameFn func(ctx context.Context, orgName string) (*domain.Organization, error)
+	FindOrganizationByIDFn   func(ctx context.Context, orgID string) (*domain.Organization, error)
 	UpdateOrganizationFn     func(ctx context.Context, org *domain.Organization) (*domain.Organization, error)
 	DeleteOrganizationFn     func(ctx context.Context, org *domain.Organization) error
 }
@@ -56,6 +64,11 @@ func (m *MockOrganizationsAPI) FindOrganizationByName(ctx context.Context, orgNa
 	return m.FindOrganizationByNameFn(ctx, orgName)
 }
 
+// FindOrganizationByID calls FindOrganizationByIDFn.
+func (m *MockOrganizationsAPI) FindOrganizationByID(ctx context.Context, orgID string) (*domain.Organization, error) {
+	return m.FindOrganizationByIDFn(ctx, orgID)
+}
+
 // UpdateOrganization calls UpdateOrganizationFn.
 func (m *MockOrganizationsAPI) UpdateOrganization(ctx context.Context, org *domain.Organization) (*domain.Organization, error) {
 	return m.UpdateOrganizationFn(ctx, org)
@@ -65,3 +78,78 @@ func (m *MockOrganizationsAPI) UpdateOrganization(ctx context.Context, org *doma
 func (m *MockOrganizationsAPI) DeleteOrganization(ctx context.Context, org *domain.Organization) error {
 	return m.DeleteOrganizationFn(ctx, org)
 }
+
+// FindOrganization returns the organization with the given name or ID. A
+// value that looks like an ID is looked up as one first, and as a name if no
+// organization has that ID.
+func FindOrganization(ctx context.Context, api OrganizationsAPI, nameOrID string) (*domain.Organization, error) {
+	if idRegex.MatchString(nameOrID) {
+		org, err := api.FindOrganizationByID(ctx, nameOrID)
+		if !IsNotFound(err) {
+			return org, err
+		}
+	}
+	return api.FindOrganizationByName(ctx, nameOrID)
+}
+
+// OrganizationMembersAPI is the set of calls we make in controllers that manage
+// members and owners of organizations.
+type OrganizationMembersAPI interface {
+	// GetMembersWithID returns all members of the organization with the given ID.
+	GetMembersWithID(ctx context.Context, orgID string) (*[]domain.ResourceMember, error)
+
+	// AddMemberWithID adds a member to the organization with the given ID.
+	AddMemberWithID(ctx context.Context, orgID, memberID string) (*domain.ResourceMember, error)
+
+	// RemoveMemberWithID removes a member from the organization with the given ID.
+	RemoveMemberWithID(ctx context.Context, orgID, memberID string) error
+
+	// GetOwnersWithID returns all owners of the organization with the given ID.
+	GetOwnersWithID(ctx context.Context, orgID string) (*[]domain.ResourceOwner, error)
+
+	// AddOwnerWithID adds an owner to the organization with the given ID.
+	AddOwnerWithID(ctx context.Context, orgID, memberID string) (*domain.ResourceOwner, error)
+
+	// RemoveOwnerWithID removes an owner from the organization with the given ID.
+	RemoveOwnerWithID(ctx context.Context, orgID, memberID string) error
+}
+
+// MockOrganizationMembersAPI mocks OrganizationMembersAPI.
+type MockOrganizationMembersAPI struct {
+	GetMembersWithIDFn   func(ctx context.Context, orgID string) (*[]domain.ResourceMember, error)
+	AddMemberWithIDFn    func(ctx context.Context, orgID, memberID string) (*domain.ResourceMember, error)
+	RemoveMemberWithIDFn func(ctx context.Context, orgID, memberID string) error
+	GetOwnersWithIDFn    func(ctx context.Context, orgID string) (*[]domain.ResourceOwner, error)
+	AddOwnerWithIDFn     func(ctx context.Context, orgID, memberID string) (*domain.ResourceOwner, error)
+	RemoveOwnerWithIDFn  func(ctx context.Context, orgID, memberID string) error
+}
+
+// GetMembersWithID calls GetMembersWithIDFn.
+func (m *MockOrganizationMembersAPI) GetMembersWithID(ctx context.Context, orgID string) (*[]domain.ResourceMember, error) {
+	return m.GetMembersWithIDFn(ctx, orgID)
+}
+
+// AddMemberWithID calls AddMemberWithIDFn.
+func (m *MockOrganizationMembersAPI) AddMemberWithID(ctx context.Context, orgID, memberID string) (*domain.ResourceMember, error) {
+	return m.AddMemberWithIDFn(ctx, orgID, memberID)
+}
+
+// RemoveMemberWithID calls RemoveMemberWithIDFn.
+func (m *MockOrganizationMembersAPI) RemoveMemberWithID(ctx context.Context, orgID, memberID string) error {
+	return m.RemoveMemberWithIDFn(ctx, orgID, memberID)
+}
+
+// GetOwnersWithID calls GetOwnersWithIDFn.
+func (m *MockOrganizationMembersAPI) GetOwnersWithID(ctx context.Context, orgID string) (*[]domain.ResourceOwner, error) {
+	return m.GetOwnersWithIDFn(ctx, orgID)
+}
+
+// AddOwnerWithID calls AddOwnerWithIDFn.
+func (m *MockOrganizationMembersAPI) AddOwnerWithID(ctx context.Context, orgID, memberID string) (*domain.ResourceOwner, error) {
+	return m.AddOwnerWithIDFn(ctx, orgID, memberID)
+}
+
+// RemoveOwnerWithID calls RemoveOwnerWithIDFn.
+func (m *MockOrganizationMembersAPI) RemoveOwnerWithID(ctx context.Context, orgID, memberID string) error {
+	return m.RemoveOwnerWithIDFn(ctx, orgID, memberID)
+}
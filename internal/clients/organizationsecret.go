@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// OrganizationSecretsAPI is the set of calls we make in controllers that use
+// the secret store of organizations.
+type OrganizationSecretsAPI interface {
+	// GetSecretKeys returns the keys in the secret store of the org with the
+	// given ID. Values are never returned by the API.
+	GetSecretKeys(ctx context.Context, orgID string) ([]string, error)
+
+	// PutSecrets adds the given key/value pairs to the secret store of the org
+	// with the given ID, overwriting the values of existing keys.
+	PutSecrets(ctx context.Context, orgID string, secrets map[string]string) error
+
+	// DeleteSecrets deletes the given keys from the secret store of the org
+	// with the given ID.
+	DeleteSecrets(ctx context.Context, orgID string, keys []string) error
+}
+
+// NewOrganizationSecretsAPI returns an OrganizationSecretsAPI that uses the
+// given client.
+func NewOrganizationSecretsAPI(c *domain.ClientWithResponses) OrganizationSecretsAPI {
+	return &organizationSecretsAPI{client: c}
+}
+
+type organizationSecretsAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *organizationSecretsAPI) GetSecretKeys(ctx context.Context, orgID string) ([]string, error) {
+	resp, err := c.client.GetOrgsIDSecretsWithResponse(ctx, orgID, &domain.GetOrgsIDSecretsParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	if resp.JSON200 == nil || resp.JSON200.Secrets == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Secrets, nil
+}
+
+func (c *organizationSecretsAPI) PutSecrets(ctx context.Context, orgID string, secrets map[string]string) error {
+	resp, err := c.client.PatchOrgsIDSecretsWithResponse(ctx, orgID, &domain.PatchOrgsIDSecretsParams{}, domain.PatchOrgsIDSecretsJSONRequestBody{AdditionalProperties: secrets})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func (c *organizationSecretsAPI) DeleteSecrets(ctx context.Context, orgID string, keys []string) error {
+	resp, err := c.client.PostOrgsIDSecretsWithResponse(ctx, orgID, &domain.PostOrgsIDSecretsParams{}, domain.PostOrgsIDSecretsJSONRequestBody{Secrets: &keys})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+// MockOrganizationSecretsAPI mocks OrganizationSecretsAPI.
+type MockOrganizationSecretsAPI struct {
+	GetSecretKeysFn func(ctx context.Context, orgID string) ([]string, error)
+	PutSecretsFn    func(ctx context.Context, orgID string, secrets map[string]string) error
+	DeleteSecretsFn func(ctx context.Context, orgID string, keys []string) error
+}
+
+// GetSecretKeys calls GetSecretKeysFn.
+func (m *MockOrganizationSecretsAPI) GetSecretKeys(ctx context.Context, orgID string) ([]string, error) {
+	return m.GetSecretKeysFn(ctx, orgID)
+}
+
+// PutSecrets calls PutSecretsFn.
+func (m *MockOrganizationSecretsAPI) PutSecrets(ctx context.Context, orgID string, secrets map[string]string) error {
+	return m.PutSecretsFn(ctx, orgID, secrets)
+}
+
+// DeleteSecrets calls DeleteSecretsFn.
+func (m *MockOrganizationSecretsAPI) DeleteSecrets(ctx context.Context, orgID string, keys []string) error {
+	return m.DeleteSecretsFn(ctx, orgID, keys)
+}
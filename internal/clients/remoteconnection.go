@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+// RemoteConnection is a connection to a remote InfluxDB instance. The API does
+// not return the token of the connection.
+type RemoteConnection struct {
+	ID               string  `json:"id,omitempty"`
+	Name             string  `json:"name"`
+	OrgID            string  `json:"orgID"`
+	Description      *string `json:"description,omitempty"`
+	RemoteURL        string  `json:"remoteURL"`
+	RemoteOrgID      string  `json:"remoteOrgID"`
+	AllowInsecureTLS bool    `json:"allowInsecureTLS"`
+}
+
+// RemoteConnectionRequest is the request to create or update a remote
+// connection. The token is only updated if it is given.
+type RemoteConnectionRequest struct {
+	Name             string  `json:"name"`
+	OrgID            string  `json:"orgID,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	RemoteURL        string  `json:"remoteURL"`
+	RemoteAPIToken   string  `json:"remoteAPIToken,omitempty"`
+	RemoteOrgID      string  `json:"remoteOrgID"`
+	AllowInsecureTLS bool    `json:"allowInsecureTLS"`
+}
+
+// RemoteConnectionsAPI is the set of calls we make in controllers that use
+// Remote Connections API, which the generated client does not support.
+type RemoteConnectionsAPI interface {
+	// GetRemoteConnectionByID returns the remote connection with the given
+	// ID.
+	GetRemoteConnectionByID(ctx context.Context, remoteID string) (*RemoteConnection, error)
+
+	// CreateRemoteConnection creates a new remote connection.
+	CreateRemoteConnection(ctx context.Context, remote RemoteConnectionRequest) (*RemoteConnection, error)
+
+	// UpdateRemoteConnection updates the remote connection with the given ID.
+	UpdateRemoteConnection(ctx context.Context, remoteID string, remote RemoteConnectionRequest) (*RemoteConnection, error)
+
+	// DeleteRemoteConnectionWithID deletes the remote connection with the
+	// given ID.
+	DeleteRemoteConnectionWithID(ctx context.Context, remoteID string) error
+}
+
+// NewRemoteConnectionsAPI returns a RemoteConnectionsAPI that uses the given
+// HTTP service.
+func NewRemoteConnectionsAPI(s apihttp.Service) RemoteConnectionsAPI {
+	return &remoteConnectionsAPI{service: s}
+}
+
+type remoteConnectionsAPI struct {
+	service apihttp.Service
+}
+
+func (c *remoteConnectionsAPI) GetRemoteConnectionByID(ctx context.Context, remoteID string) (*RemoteConnection, error) {
+	out := &RemoteConnection{}
+	return out, doRequest(ctx, c.service, http.MethodGet, "remotes/"+url.PathEscape(remoteID), nil, out)
+}
+
+func (c *remoteConnectionsAPI) CreateRemoteConnection(ctx context.Context, remote RemoteConnectionRequest) (*RemoteConnection, error) {
+	out := &RemoteConnection{}
+	return out, doRequest(ctx, c.service, http.MethodPost, "remotes", remote, out)
+}
+
+func (c *remoteConnectionsAPI) UpdateRemoteConnection(ctx context.Context, remoteID string, remote RemoteConnectionRequest) (*RemoteConnection, error) {
+	// The org of a remote connection cannot be changed.
+	remote.OrgID = ""
+	out := &RemoteConnection{}
+	return out, doRequest(ctx, c.service, http.MethodPatch, "remotes/"+url.PathEscape(remoteID), remote, out)
+}
+
+func (c *remoteConnectionsAPI) DeleteRemoteConnectionWithID(ctx context.Context, remoteID string) error {
+	return doRequest(ctx, c.service, http.MethodDelete, "remotes/"+url.PathEscape(remoteID), nil, nil)
+}
+
+// MockRemoteConnectionsAPI mocks RemoteConnectionsAPI.
+type MockRemoteConnectionsAPI struct {
+	GetRemoteConnectionByIDFn      func(ctx context.Context, remoteID string) (*RemoteConnection, error)
+	CreateRemoteConnectionFn       func(ctx context.Context, remote RemoteConnectionRequest) (*RemoteConnection, error)
+	UpdateRemoteConnectionFn       func(ctx context.Context, remoteID string, remote RemoteConnectionRequest) (*RemoteConnection, error)
+	DeleteRemoteConnectionWithIDFn func(ctx context.Context, remoteID string) error
+}
+
+// GetRemoteConnectionByID calls GetRemoteConnectionByIDFn.
+func (m *MockRemoteConnectionsAPI) GetRemoteConnectionByID(ctx context.Context, remoteID string) (*RemoteConnection, error) {
+	return m.GetRemoteConnectionByIDFn(ctx, remoteID)
+}
+
+// CreateRemoteConnection calls CreateRemoteConnectionFn.
+func (m *MockRemoteConnectionsAPI) CreateRemoteConnection(ctx context.Context, remote RemoteConnectionRequest) (*RemoteConnection, error) {
+	return m.CreateRemoteConnectionFn(ctx, remote)
+}
+
+// UpdateRemoteConnection calls UpdateRemoteConnectionFn.
+func (m *MockRemoteConnectionsAPI) UpdateRemoteConnection(ctx context.Context, remoteID string, remote RemoteConnectionRequest) (*RemoteConnection, error) {
+	return m.UpdateRemoteConnectionFn(ctx, remoteID, remote)
+}
+
+// DeleteRemoteConnectionWithID calls DeleteRemoteConnectionWithIDFn.
+func (m *MockRemoteConnectionsAPI) DeleteRemoteConnectionWithID(ctx context.Context, remoteID string) error {
+	return m.DeleteRemoteConnectionWithIDFn(ctx, remoteID)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+// Replication is the replication of a local bucket to a remote InfluxDB
+// instance.
+type Replication struct {
+	ID                    string  `json:"id,omitempty"`
+	Name                  string  `json:"name"`
+	Description           *string `json:"description,omitempty"`
+	OrgID                 string  `json:"orgID"`
+	RemoteID              string  `json:"remoteID"`
+	LocalBucketID         string  `json:"localBucketID"`
+	RemoteBucketID        string  `json:"remoteBucketID"`
+	MaxQueueSizeBytes     int64   `json:"maxQueueSizeBytes"`
+	CurrentQueueSizeBytes int64   `json:"currentQueueSizeBytes"`
+	LatestResponseCode    *int32  `json:"latestResponseCode,omitempty"`
+	LatestErrorMessage    *string `json:"latestErrorMessage,omitempty"`
+	DropNonRetryableData  bool    `json:"dropNonRetryableData"`
+}
+
+// ReplicationRequest is the request to create or update a replication. The org
+// and the local bucket are ignored in updates.
+type ReplicationRequest struct {
+	Name                 string  `json:"name"`
+	Description          *string `json:"description,omitempty"`
+	OrgID                string  `json:"orgID,omitempty"`
+	RemoteID             string  `json:"remoteID"`
+	LocalBucketID        string  `json:"localBucketID,omitempty"`
+	RemoteBucketID       string  `json:"remoteBucketID"`
+	MaxQueueSizeBytes    *int64  `json:"maxQueueSizeBytes,omitempty"`
+	DropNonRetryableData *bool   `json:"dropNonRetryableData,omitempty"`
+}
+
+// ReplicationsAPI is the set of calls we make in controllers that use
+// Replications API, which the generated client does not support.
+type ReplicationsAPI interface {
+	// GetReplicationByID returns the replication with the given ID.
+	GetReplicationByID(ctx context.Context, replicationID string) (*Replication, error)
+
+	// CreateReplication creates a new replication.
+	CreateReplication(ctx context.Context, replication ReplicationRequest) (*Replication, error)
+
+	// UpdateReplication updates the replication with the given ID.
+	UpdateReplication(ctx context.Context, replicationID string, replication ReplicationRequest) (*Replication, error)
+
+	// DeleteReplicationWithID deletes the replication with the given ID.
+	DeleteReplicationWithID(ctx context.Context, replicationID string) error
+}
+
+// NewReplicationsAPI returns a ReplicationsAPI that uses the given HTTP
+// service.
+func NewReplicationsAPI(s apihttp.Service) ReplicationsAPI {
+	return &replicationsAPI{service: s}
+}
+
+type replicationsAPI struct {
+	service apihttp.Service
+}
+
+func (c *replicationsAPI) GetReplicationByID(ctx context.Context, replicationID string) (*Replication, error) {
+	out := &Replication{}
+	return out, doRequest(ctx, c.service, http.MethodGet, "replications/"+url.PathEscape(replicationID), nil, out)
+}
+
+func (c *replicationsAPI) CreateReplication(ctx context.Context, replication ReplicationRequest) (*Replication, error) {
+	out := &Replication{}
+	return out, doRequest(ctx, c.service, http.MethodPost, "replications", replication, out)
+}
+
+func (c *replicationsAPI) UpdateReplication(ctx context.Context, replicationID string, replication ReplicationRequest) (*Replication, error) {
+	replication.OrgID = ""
+	replication.LocalBucketID = ""
+	out := &Replication{}
+	return out, doRequest(ctx, c.service, http.MethodPatch, "replications/"+url.PathEscape(replicationID), replication, out)
+}
+
+func (c *replicationsAPI) DeleteReplicationWithID(ctx context.Context, replicationID string) error {
+	return doRequest(ctx, c.service, http.MethodDelete, "replications/"+url.PathEscape(replicationID), nil, nil)
+}
+
+// MockReplicationsAPI mocks ReplicationsAPI.
+type MockReplicationsAPI struct {
+	GetReplicationByIDFn      func(ctx context.Context, replicationID string) (*Replication, error)
+	CreateReplicationFn       func(ctx context.Context, replication ReplicationRequest) (*Replication, error)
+	UpdateReplicationFn       func(ctx context.Context, replicationID string, replication ReplicationRequest) (*Replication, error)
+	DeleteReplicationWithIDFn func(ctx context.Context, replicationID string) error
+}
+
+// GetReplicationByID calls GetReplicationByIDFn.
+func (m *MockReplicationsAPI) GetReplicationByID(ctx context.Context, replicationID string) (*Replication, error) {
+	return m.GetReplicationByIDFn(ctx, replicationID)
+}
+
+// CreateReplication calls CreateReplicationFn.
+func (m *MockReplicationsAPI) CreateReplication(ctx context.Context, replication ReplicationRequest) (*Replication, error) {
+	return m.CreateReplicationFn(ctx, replication)
+}
+
+// UpdateReplication calls UpdateReplicationFn.
+func (m *MockReplicationsAPI) UpdateReplication(ctx context.Context, replicationID string, replication ReplicationRequest) (*Replication, error) {
+	return m.UpdateReplicationFn(ctx, replicationID, replication)
+}
+
+// DeleteReplicationWithID calls DeleteReplicationWithIDFn.
+func (m *MockReplicationsAPI) DeleteReplicationWithID(ctx context.Context, replicationID string) error {
+	return m.DeleteReplicationWithIDFn(ctx, replicationID)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// ScraperTargetsAPI is the set of calls we make in controllers that use
+// Scraper Targets API.
+type ScraperTargetsAPI interface {
+	// GetScraperTargetByID returns the scraper target with the given ID.
+	GetScraperTargetByID(ctx context.Context, scraperTargetID string) (*domain.ScraperTargetResponse, error)
+
+	// CreateScraperTarget creates a new scraper target.
+	CreateScraperTarget(ctx context.Context, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error)
+
+	// UpdateScraperTarget updates the scraper target with the given ID.
+	UpdateScraperTarget(ctx context.Context, scraperTargetID string, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error)
+
+	// DeleteScraperTargetWithID deletes the scraper target with the given ID.
+	DeleteScraperTargetWithID(ctx context.Context, scraperTargetID string) error
+}
+
+// NewScraperTargetsAPI returns a ScraperTargetsAPI that uses the given
+// client.
+func NewScraperTargetsAPI(c *domain.ClientWithResponses) ScraperTargetsAPI {
+	return &scraperTargetsAPI{client: c}
+}
+
+type scraperTargetsAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *scraperTargetsAPI) GetScraperTargetByID(ctx context.Context, scraperTargetID string) (*domain.ScraperTargetResponse, error) {
+	resp, err := c.client.GetScrapersIDWithResponse(ctx, scraperTargetID, &domain.GetScrapersIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *scraperTargetsAPI) CreateScraperTarget(ctx context.Context, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+	resp, err := c.client.PostScrapersWithResponse(ctx, &domain.PostScrapersParams{}, domain.PostScrapersJSONRequestBody(target))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON201, nil
+}
+
+func (c *scraperTargetsAPI) UpdateScraperTarget(ctx context.Context, scraperTargetID string, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+	resp, err := c.client.PatchScrapersIDWithResponse(ctx, scraperTargetID, &domain.PatchScrapersIDParams{}, domain.PatchScrapersIDJSONRequestBody(target))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *scraperTargetsAPI) DeleteScraperTargetWithID(ctx context.Context, scraperTargetID string) error {
+	resp, err := c.client.DeleteScrapersIDWithResponse(ctx, scraperTargetID, &domain.DeleteScrapersIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+// MockScraperTargetsAPI mocks ScraperTargetsAPI.
+type MockScraperTargetsAPI struct {
+	GetScraperTargetByIDFn      func(ctx context.Context, scraperTargetID string) (*domain.ScraperTargetResponse, error)
+	CreateScraperTargetFn       func(ctx context.Context, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error)
+	UpdateScraperTargetFn       func(ctx context.Context, scraperTargetID string, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error)
+	DeleteScraperTargetWithIDFn func(ctx context.Context, scraperTargetID string) error
+}
+
+// GetScraperTargetByID calls GetScraperTargetByIDFn.
+func (m *MockScraperTargetsAPI) GetScraperTargetByID(ctx context.Context, scraperTargetID string) (*domain.ScraperTargetResponse, error) {
+	return m.GetScraperTargetByIDFn(ctx, scraperTargetID)
+}
+
+// CreateScraperTarget calls CreateScraperTargetFn.
+func (m *MockScraperTargetsAPI) CreateScraperTarget(ctx context.Context, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+	return m.CreateScraperTargetFn(ctx, target)
+}
+
+// UpdateScraperTarget calls UpdateScraperTargetFn.
+func (m *MockScraperTargetsAPI) UpdateScraperTarget(ctx context.Context, scraperTargetID string, target domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+	return m.UpdateScraperTargetFn(ctx, scraperTargetID, target)
+}
+
+// DeleteScraperTargetWithID calls DeleteScraperTargetWithIDFn.
+func (m *MockScraperTargetsAPI) DeleteScraperTargetWithID(ctx context.Context, scraperTargetID string) error {
+	return m.DeleteScraperTargetWithIDFn(ctx, scraperTargetID)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SecretVersion returns the version of the given Secrets that is recorded in
+// status when the values read from them are applied. Changes in the values are
+// detected by comparing it with the version of the Secrets that are read in
+// the next observation, so that neither the values nor anything derived from
+// them end up in status. It is empty if no Secrets are given.
+//
+// Status written during creation is not persisted, so the values are applied
+// once more in the first update, which is harmless.
+func SecretVersion(secrets ...*corev1.Secret) string {
+	versions := make([]string, 0, len(secrets))
+	seen := map[string]bool{}
+	for _, s := range secrets {
+		v := s.GetResourceVersion()
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		versions = append(versions, v)
+	}
+	// The same Secrets are not necessarily read in the same order.
+	sort.Strings(versions)
+	return strings.Join(versions, ",")
+}
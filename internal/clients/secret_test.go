@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSecretVersion(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		secrets []*corev1.Secret
+		want    string
+	}{
+		"NoSecrets": {
+			reason: "We should return an empty version if no Secrets are given.",
+		},
+		"SingleSecret": {
+			reason:  "We should return the resource version of a single Secret.",
+			secrets: []*corev1.Secret{{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"}}},
+			want:    "1",
+		},
+		"MultipleSecrets": {
+			reason: "We should return the same version regardless of the order and repetition of the Secrets.",
+			secrets: []*corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"}},
+				{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"}},
+				{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"}},
+			},
+			want: "1,2",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SecretVersion(tc.secrets...)); diff != "" {
+				t.Errorf("\n%s\nSecretVersion(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
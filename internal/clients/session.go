@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+)
+
+const (
+	errSignin = "cannot sign in"
+)
+
+// sessions holds the session cookies of all InfluxDB instances that the
+// provider signed in to, so that it does not sign in on every reconcile.
+var sessions = &sessionStore{sessions: map[string]session{}}
+
+// A session is the cookies of a user that signed in with a specific password.
+type session struct {
+	password string
+	cookies  []*http.Cookie
+}
+
+// sessionStore holds one session per user of an InfluxDB instance. Signing in
+// with a new password replaces the session of the old one.
+type sessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]session
+}
+
+// get returns the cookies of the session of the given user if it was created
+// with the given password hash.
+func (s *sessionStore) get(key, password string) ([]*http.Cookie, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ss, ok := s.sessions[key]
+	if !ok || ss.password != password {
+		return nil, false
+	}
+	return ss.cookies, true
+}
+
+func (s *sessionStore) set(key, password string, c []*http.Cookie) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[key] = session{password: password, cookies: c}
+}
+
+// sessionTransport authenticates requests with a session cookie. It signs in
+// if there is no session yet, and signs in again and retries the request once
+// if the session has expired.
+type sessionTransport struct {
+	base      http.RoundTripper
+	store     *sessionStore
+	signinURL string
+	username  string
+	password  string
+}
+
+func newSessionTransport(base http.RoundTripper, endpoint, username, password string) *sessionTransport {
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	return &sessionTransport{
+		base:      base,
+		store:     sessions,
+		signinURL: endpoint + "api/v2/signin",
+		username:  username,
+		password:  password,
+	}
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cookies, cached := t.store.get(t.key(), t.passwordHash())
+	if !cached {
+		var err error
+		if cookies, err = t.signin(req); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := t.base.RoundTrip(withCookies(req, cookies))
+	// A request whose body cannot be read again cannot be retried.
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !cached || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	// The session has expired.
+	_ = resp.Body.Close()
+	if cookies, err = t.signin(req); err != nil {
+		return nil, err
+	}
+	retry := withCookies(req, cookies)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(retry)
+}
+
+// signin signs in with the username and password and stores the cookies of
+// the new session.
+func (t *sessionTransport) signin(orig *http.Request) ([]*http.Cookie, error) {
+	req, err := http.NewRequestWithContext(orig.Context(), http.MethodPost, t.signinURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errSignin)
+	}
+	req.SetBasicAuth(t.username, t.password)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errSignin)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// The status code is kept so that IsUnauthorized works with it.
+		return nil, &apihttp.Error{StatusCode: resp.StatusCode, Err: errors.Errorf("%s: %s", errSignin, resp.Status)}
+	}
+	cookies := resp.Cookies()
+	t.store.set(t.key(), t.passwordHash(), cookies)
+	return cookies, nil
+}
+
+// key identifies the user in the InfluxDB instance.
+func (t *sessionTransport) key() string {
+	return t.signinURL + "\x00" + t.username
+}
+
+// passwordHash returns the hash of the password so that a session is not
+// reused after the password changes, without keeping the password around.
+func (t *sessionTransport) passwordHash() string {
+	h := sha256.Sum256([]byte(t.password))
+	return hex.EncodeToString(h[:])
+}
+
+// withCookies returns a copy of the request with the given cookies added.
+// RoundTrippers must not modify the original request.
+func withCookies(req *http.Request, cookies []*http.Cookie) *http.Request {
+	out := req.Clone(req.Context())
+	for _, c := range cookies {
+		out.AddCookie(c)
+	}
+	return out
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// influxdb is a fake InfluxDB instance that accepts the given password and
+// serves requests that carry the cookie of the latest session.
+type influxdb struct {
+	password string
+	signins  int
+	bodies   []string
+}
+
+func (i *influxdb) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/v2/signin" {
+		if u, p, ok := r.BasicAuth(); !ok || u != "admin" || p != i.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		i.signins++
+		http.SetCookie(w, &http.Cookie{Name: "influxdb-oss-session", Value: i.session()})
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	c, err := r.Cookie("influxdb-oss-session")
+	if err != nil || c.Value != i.session() {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	b, _ := io.ReadAll(r.Body)
+	i.bodies = append(i.bodies, string(b))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (i *influxdb) session() string {
+	return "session-" + strconv.Itoa(i.signins)
+}
+
+func passwordHash(password string) string {
+	return (&sessionTransport{password: password}).passwordHash()
+}
+
+func TestSessionTransport(t *testing.T) {
+	type args struct {
+		accepted string
+		password string
+		sessions map[string]session
+		requests int
+		body     string
+	}
+	type want struct {
+		status       int
+		signins      int
+		bodies       []string
+		sessions     int
+		unauthorized bool
+	}
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"FirstSignin": {
+			reason: "We should sign in if there is no session yet.",
+			args: args{
+				accepted: "secret",
+				password: "secret",
+				requests: 1,
+			},
+			want: want{
+				status:   http.StatusOK,
+				signins:  1,
+				bodies:   []string{""},
+				sessions: 1,
+			},
+		},
+		"CachedSession": {
+			reason: "We should reuse the cookie of the session for the subsequent requests.",
+			args: args{
+				accepted: "secret",
+				password: "secret",
+				requests: 3,
+			},
+			want: want{
+				status:   http.StatusOK,
+				signins:  1,
+				bodies:   []string{"", "", ""},
+				sessions: 1,
+			},
+		},
+		"ExpiredSession": {
+			reason: "We should sign in again and replay the request if the session has expired.",
+			args: args{
+				accepted: "secret",
+				password: "secret",
+				sessions: map[string]session{
+					"admin": {password: passwordHash("secret"), cookies: []*http.Cookie{{Name: "influxdb-oss-session", Value: "expired"}}},
+				},
+				requests: 1,
+				body:     `{"name":"bucket"}`,
+			},
+			want: want{
+				status:   http.StatusOK,
+				signins:  1,
+				bodies:   []string{`{"name":"bucket"}`},
+				sessions: 1,
+			},
+		},
+		"PasswordRotated": {
+			reason: "We should replace the session of the old password instead of keeping both.",
+			args: args{
+				accepted: "rotated",
+				password: "rotated",
+				sessions: map[string]session{
+					"admin": {password: passwordHash("secret"), cookies: []*http.Cookie{{Name: "influxdb-oss-session", Value: "session-0"}}},
+				},
+				requests: 1,
+			},
+			want: want{
+				status:   http.StatusOK,
+				signins:  1,
+				bodies:   []string{""},
+				sessions: 1,
+			},
+		},
+		"SigninFailed": {
+			reason: "We should return an unauthorized error if InfluxDB rejects the credentials.",
+			args: args{
+				accepted: "secret",
+				password: "wrong",
+				requests: 1,
+			},
+			want: want{
+				sessions:     0,
+				unauthorized: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			db := &influxdb{password: tc.args.accepted}
+			srv := httptest.NewServer(db)
+			defer srv.Close()
+
+			st := newSessionTransport(http.DefaultTransport, srv.URL, "admin", tc.args.password)
+			st.store = &sessionStore{sessions: map[string]session{}}
+			for k, v := range tc.args.sessions {
+				st.store.sessions[st.signinURL+"\x00"+k] = v
+			}
+			hc := &http.Client{Transport: st}
+
+			var resp *http.Response
+			var err error
+			for i := 0; i < tc.args.requests; i++ {
+				var req *http.Request
+				req, err = http.NewRequest(http.MethodPost, srv.URL+"/api/v2/buckets", bytes.NewBufferString(tc.args.body))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp, err = hc.Do(req); err != nil {
+					break
+				}
+				_ = resp.Body.Close()
+			}
+			switch {
+			case tc.want.unauthorized:
+				if !IsUnauthorized(err) {
+					t.Errorf("\n%s\nRoundTrip(...): want an unauthorized error, got %v", tc.reason, err)
+				}
+			case err != nil:
+				t.Fatalf("\n%s\nRoundTrip(...): %s", tc.reason, err)
+			default:
+				if diff := cmp.Diff(tc.want.status, resp.StatusCode); diff != "" {
+					t.Errorf("\n%s\nRoundTrip(...): -want status, +got status:\n%s", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.signins, db.signins); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want sign-ins, +got sign-ins:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, db.bodies); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want bodies, +got bodies:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sessions, len(st.store.sessions)); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want sessions, +got sessions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
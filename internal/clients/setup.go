@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+)
+
+// SetupAPI is the set of calls we make in controllers that onboard InfluxDB
+// instances. These calls do not need authentication.
+type SetupAPI interface {
+	// IsOnboarded returns whether the initial setup of the instance is done.
+	IsOnboarded(ctx context.Context) (bool, error)
+
+	// Onboard does the initial setup of the instance and returns the created
+	// resources, including the operator token.
+	Onboard(ctx context.Context, req *domain.OnboardingRequest) (*domain.OnboardingResponse, error)
+}
+
+// NewSetupAPI returns a SetupAPI that uses the given HTTP service.
+func NewSetupAPI(s apihttp.Service) SetupAPI {
+	return &setupAPI{service: s}
+}
+
+type setupAPI struct {
+	service apihttp.Service
+}
+
+func (c *setupAPI) IsOnboarded(ctx context.Context) (bool, error) {
+	out := &domain.IsOnboarding{}
+	if err := doRequest(ctx, c.service, http.MethodGet, "setup", nil, out); err != nil {
+		return false, err
+	}
+	// Allowed means that the onboarding is still possible, i.e. it is not
+	// done yet.
+	return !pointer.BoolDeref(out.Allowed, false), nil
+}
+
+func (c *setupAPI) Onboard(ctx context.Context, req *domain.OnboardingRequest) (*domain.OnboardingResponse, error) {
+	out := &domain.OnboardingResponse{}
+	return out, doRequest(ctx, c.service, http.MethodPost, "setup", req, out)
+}
+
+// MockSetupAPI mocks SetupAPI.
+type MockSetupAPI struct {
+	IsOnboardedFn func(ctx context.Context) (bool, error)
+	OnboardFn     func(ctx context.Context, req *domain.OnboardingRequest) (*domain.OnboardingResponse, error)
+}
+
+// IsOnboarded calls IsOnboardedFn.
+func (m *MockSetupAPI) IsOnboarded(ctx context.Context) (bool, error) {
+	return m.IsOnboardedFn(ctx)
+}
+
+// Onboard calls OnboardFn.
+func (m *MockSetupAPI) Onboard(ctx context.Context, req *domain.OnboardingRequest) (*domain.OnboardingResponse, error) {
+	return m.OnboardFn(ctx, req)
+}
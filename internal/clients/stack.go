@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+)
+
+// maxTemplateSize is the maximum size of a template that is fetched from a
+// URL.
+const maxTemplateSize = 10 << 20
+
+// TemplateApplyRequest is the request to apply a template. The generated model
+// is not used since the template contents are passed through as they are.
+type TemplateApplyRequest struct {
+	DryRun   bool              `json:"dryRun"`
+	OrgID    string            `json:"orgID"`
+	StackID  string            `json:"stackID,omitempty"`
+	Template *TemplateContents `json:"template,omitempty"`
+	EnvRefs  map[string]string `json:"envRefs,omitempty"`
+}
+
+// TemplateContents is a template that is sent in the body of the request.
+type TemplateContents struct {
+	ContentType string          `json:"contentType"`
+	Contents    json.RawMessage `json:"contents"`
+}
+
+// TemplateSummary is the result of applying a template. Only the diff is
+// decoded since the generated model does not tell the resource kinds apart.
+type TemplateSummary struct {
+	StackID string                         `json:"stackID"`
+	Diff    map[string][]TemplateDiffEntry `json:"diff"`
+}
+
+// TemplateDiffEntry is a change to a single resource of a template.
+type TemplateDiffEntry struct {
+	Kind             string `json:"kind"`
+	TemplateMetaName string `json:"templateMetaName"`
+	StateStatus      string `json:"stateStatus"`
+}
+
+// StacksAPI is the set of calls we make in controllers that use Stacks and
+// Templates API.
+type StacksAPI interface {
+	// ReadStack returns the stack with the given ID.
+	ReadStack(ctx context.Context, stackID string) (*domain.Stack, error)
+
+	// CreateStack creates a new stack without installing any template.
+	CreateStack(ctx context.Context, stack domain.CreateStackJSONBody) (*domain.Stack, error)
+
+	// UpdateStack updates the name, description and template URLs of the
+	// stack with the given ID.
+	UpdateStack(ctx context.Context, stackID string, stack domain.UpdateStackJSONBody) (*domain.Stack, error)
+
+	// UninstallStack removes all resources of the stack with the given ID.
+	UninstallStack(ctx context.Context, stackID string) error
+
+	// DeleteStack deletes the stack with the given ID.
+	DeleteStack(ctx context.Context, stackID, orgID string) error
+
+	// ApplyTemplate installs the template of the request, or only reports
+	// what would change if it is a dry run.
+	ApplyTemplate(ctx context.Context, req TemplateApplyRequest) (*TemplateSummary, error)
+}
+
+// NewStacksAPI returns a StacksAPI that uses the given client.
+func NewStacksAPI(c *domain.ClientWithResponses) StacksAPI {
+	return &stacksAPI{client: c}
+}
+
+type stacksAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *stacksAPI) ReadStack(ctx context.Context, stackID string) (*domain.Stack, error) {
+	resp, err := c.client.ReadStackWithResponse(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *stacksAPI) CreateStack(ctx context.Context, stack domain.CreateStackJSONBody) (*domain.Stack, error) {
+	resp, err := c.client.CreateStackWithResponse(ctx, domain.CreateStackJSONRequestBody(stack))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON201, nil
+}
+
+func (c *stacksAPI) UpdateStack(ctx context.Context, stackID string, stack domain.UpdateStackJSONBody) (*domain.Stack, error) {
+	resp, err := c.client.UpdateStackWithResponse(ctx, stackID, domain.UpdateStackJSONRequestBody(stack))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *stacksAPI) UninstallStack(ctx context.Context, stackID string) error {
+	resp, err := c.client.UninstallStackWithResponse(ctx, stackID)
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func (c *stacksAPI) DeleteStack(ctx context.Context, stackID, orgID string) error {
+	resp, err := c.client.DeleteStackWithResponse(ctx, stackID, &domain.DeleteStackParams{OrgID: orgID})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func (c *stacksAPI) ApplyTemplate(ctx context.Context, req TemplateApplyRequest) (*TemplateSummary, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ApplyTemplateWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	out := &TemplateSummary{}
+	return out, json.Unmarshal(resp.Body, out)
+}
+
+// TemplateFetcher fetches templates that are given by URL.
+type TemplateFetcher interface {
+	// FetchTemplate returns the contents of the template at the given URL.
+	FetchTemplate(ctx context.Context, url string) (string, error)
+}
+
+// NewTemplateFetcher returns a TemplateFetcher that uses the given client.
+func NewTemplateFetcher(hc *http.Client) TemplateFetcher {
+	return &templateFetcher{client: hc}
+}
+
+type templateFetcher struct {
+	client *http.Client
+}
+
+func (f *templateFetcher) FetchTemplate(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode/100 != 2 {
+		return "", errors.Errorf("unexpected status %s", resp.Status)
+	}
+	// One more byte than allowed is read to tell whether the template is too
+	// large.
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxTemplateSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(b) > maxTemplateSize {
+		return "", errors.Errorf("template is larger than %d bytes", maxTemplateSize)
+	}
+	return string(b), nil
+}
+
+// MockStacksAPI mocks StacksAPI.
+type MockStacksAPI struct {
+	ReadStackFn      func(ctx context.Context, stackID string) (*domain.Stack, error)
+	CreateStackFn    func(ctx context.Context, stack domain.CreateStackJSONBody) (*domain.Stack, error)
+	UpdateStackFn    func(ctx context.Context, stackID string, stack domain.UpdateStackJSONBody) (*domain.Stack, error)
+	UninstallStackFn func(ctx context.Context, stackID string) error
+	DeleteStackFn    func(ctx context.Context, stackID, orgID string) error
+	ApplyTemplateFn  func(ctx context.Context, req TemplateApplyRequest) (*TemplateSummary, error)
+}
+
+// ReadStack calls ReadStackFn.
+func (m *MockStacksAPI) ReadStack(ctx context.Context, stackID string) (*domain.Stack, error) {
+	return m.ReadStackFn(ctx, stackID)
+}
+
+// CreateStack calls CreateStackFn.
+func (m *MockStacksAPI) CreateStack(ctx context.Context, stack domain.CreateStackJSONBody) (*domain.Stack, error) {
+	return m.CreateStackFn(ctx, stack)
+}
+
+// UpdateStack calls UpdateStackFn.
+func (m *MockStacksAPI) UpdateStack(ctx context.Context, stackID string, stack domain.UpdateStackJSONBody) (*domain.Stack, error) {
+	return m.UpdateStackFn(ctx, stackID, stack)
+}
+
+// UninstallStack calls UninstallStackFn.
+func (m *MockStacksAPI) UninstallStack(ctx context.Context, stackID string) error {
+	return m.UninstallStackFn(ctx, stackID)
+}
+
+// DeleteStack calls DeleteStackFn.
+func (m *MockStacksAPI) DeleteStack(ctx context.Context, stackID, orgID string) error {
+	return m.DeleteStackFn(ctx, stackID, orgID)
+}
+
+// ApplyTemplate calls ApplyTemplateFn.
+func (m *MockStacksAPI) ApplyTemplate(ctx context.Context, req TemplateApplyRequest) (*TemplateSummary, error) {
+	return m.ApplyTemplateFn(ctx, req)
+}
+
+// MockTemplateFetcher mocks TemplateFetcher.
+type MockTemplateFetcher struct {
+	FetchTemplateFn func(ctx context.Context, url string) (string, error)
+}
+
+// FetchTemplate calls FetchTemplateFn.
+func (m *MockTemplateFetcher) FetchTemplate(ctx context.Context, url string) (string, error) {
+	return m.FetchTemplateFn(ctx, url)
+}
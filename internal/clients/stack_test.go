@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestFetchTemplate(t *testing.T) {
+	type args struct {
+		status int
+		body   string
+	}
+	type want struct {
+		template string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "We should return the template as it is served.",
+			args: args{
+				status: http.StatusOK,
+				body:   "apiVersion: influxdata.com/v2alpha1",
+			},
+			want: want{
+				template: "apiVersion: influxdata.com/v2alpha1",
+			},
+		},
+		"MaxSize": {
+			reason: "We should return a template of exactly the maximum size.",
+			args: args{
+				status: http.StatusOK,
+				body:   strings.Repeat("a", maxTemplateSize),
+			},
+			want: want{
+				template: strings.Repeat("a", maxTemplateSize),
+			},
+		},
+		"TooLarge": {
+			reason: "We should return an error instead of a truncated template.",
+			args: args{
+				status: http.StatusOK,
+				body:   strings.Repeat("a", maxTemplateSize+1),
+			},
+			want: want{
+				err: errors.Errorf("template is larger than %d bytes", maxTemplateSize),
+			},
+		},
+		"UnexpectedStatus": {
+			reason: "We should return an error if the template cannot be served.",
+			args: args{
+				status: http.StatusNotFound,
+			},
+			want: want{
+				err: errors.New("unexpected status 404 Not Found"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.args.status)
+				_, _ = w.Write([]byte(tc.args.body))
+			}))
+			defer srv.Close()
+
+			got, err := NewTemplateFetcher(srv.Client()).FetchTemplate(context.TODO(), srv.URL)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFetchTemplate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.template, got); diff != "" {
+				t.Errorf("\n%s\nFetchTemplate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
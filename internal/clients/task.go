@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// TasksAPI is the set of calls we make in controllers that use Tasks API.
+type TasksAPI interface {
+	// GetTaskByID returns a task found using id.
+	GetTaskByID(ctx context.Context, taskID string) (*domain.Task, error)
+
+	// UpdateTask updates a task.
+	UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error)
+
+	// DeleteTaskWithID deletes the task with the given ID.
+	DeleteTaskWithID(ctx context.Context, taskID string) error
+
+	// FindTasks retrieves tasks according to the filter.
+	FindTasks(ctx context.Context, filter *api.TaskFilter) ([]domain.Task, error)
+
+	// GetRunByID returns the run with the given ID of the task with the given
+	// ID.
+	GetRunByID(ctx context.Context, taskID, runID string) (*domain.Run, error)
+
+	// FindRunLogsWithID returns all log events for the run with the given ID
+	// of the task with the given ID.
+	FindRunLogsWithID(ctx context.Context, taskID, runID string) ([]domain.LogEvent, error)
+
+	// CancelRunWithID cancels the run with the given ID of the task with the
+	// given ID.
+	CancelRunWithID(ctx context.Context, taskID, runID string) error
+}
+
+// MockTasksAPI mocks TasksAPI.
+type MockTasksAPI struct {
+	GetTaskByIDFn       func(ctx context.Context, taskID string) (*domain.Task, error)
+	UpdateTaskFn        func(ctx context.Context, task *domain.Task) (*domain.Task, error)
+	DeleteTaskWithIDFn  func(ctx context.Context, taskID string) error
+	FindTasksFn         func(ctx context.Context, filter *api.TaskFilter) ([]domain.Task, error)
+	GetRunByIDFn        func(ctx context.Context, taskID, runID string) (*domain.Run, error)
+	FindRunLogsWithIDFn func(ctx context.Context, taskID, runID string) ([]domain.LogEvent, error)
+	CancelRunWithIDFn   func(ctx context.Context, taskID, runID string) error
+}
+
+// GetTaskByID calls GetTaskByIDFn.
+func (m *MockTasksAPI) GetTaskByID(ctx context.Context, taskID string) (*domain.Task, error) {
+	return m.GetTaskByIDFn(ctx, taskID)
+}
+
+// UpdateTask calls UpdateTaskFn.
+func (m *MockTasksAPI) UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	return m.UpdateTaskFn(ctx, task)
+}
+
+// DeleteTaskWithID calls DeleteTaskWithIDFn.
+func (m *MockTasksAPI) DeleteTaskWithID(ctx context.Context, taskID string) error {
+	return m.DeleteTaskWithIDFn(ctx, taskID)
+}
+
+// FindTasks calls FindTasksFn.
+func (m *MockTasksAPI) FindTasks(ctx context.Context, filter *api.TaskFilter) ([]domain.Task, error) {
+	return m.FindTasksFn(ctx, filter)
+}
+
+// GetRunByID calls GetRunByIDFn.
+func (m *MockTasksAPI) GetRunByID(ctx context.Context, taskID, runID string) (*domain.Run, error) {
+	return m.GetRunByIDFn(ctx, taskID, runID)
+}
+
+// FindRunLogsWithID calls FindRunLogsWithIDFn.
+func (m *MockTasksAPI) FindRunLogsWithID(ctx context.Context, taskID, runID string) ([]domain.LogEvent, error) {
+	return m.FindRunLogsWithIDFn(ctx, taskID, runID)
+}
+
+// CancelRunWithID calls CancelRunWithIDFn.
+func (m *MockTasksAPI) CancelRunWithID(ctx context.Context, taskID, runID string) error {
+	return m.CancelRunWithIDFn(ctx, taskID, runID)
+}
+
+// TaskRunsAPI is the set of calls we make in controllers to start manual runs
+// of tasks. TasksAPI does not allow setting the scheduled time of the run.
+type TaskRunsAPI interface {
+	PostTasksIDRunsWithResponse(ctx context.Context, taskID string, params *domain.PostTasksIDRunsParams, body domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error)
+}
+
+// MockTaskRunsAPI mocks TaskRunsAPI.
+type MockTaskRunsAPI struct {
+	PostTasksIDRunsWithResponseFn func(ctx context.Context, taskID string, params *domain.PostTasksIDRunsParams, body domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error)
+}
+
+// PostTasksIDRunsWithResponse calls PostTasksIDRunsWithResponseFn.
+func (m *MockTaskRunsAPI) PostTasksIDRunsWithResponse(ctx context.Context, taskID string, params *domain.PostTasksIDRunsParams, body domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error) {
+	return m.PostTasksIDRunsWithResponseFn(ctx, taskID, params, body)
+}
+
+// TaskCreateAPI is the set of calls we make in controllers to create tasks
+// with a Flux script that already contains its task option statement.
+type TaskCreateAPI interface {
+	PostTasksWithResponse(ctx context.Context, params *domain.PostTasksParams, body domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error)
+}
+
+// MockTaskCreateAPI mocks TaskCreateAPI.
+type MockTaskCreateAPI struct {
+	PostTasksWithResponseFn func(ctx context.Context, params *domain.PostTasksParams, body domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error)
+}
+
+// PostTasksWithResponse calls PostTasksWithResponseFn.
+func (m *MockTaskCreateAPI) PostTasksWithResponse(ctx context.Context, params *domain.PostTasksParams, body domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error) {
+	return m.PostTasksWithResponseFn(ctx, params, body)
+}
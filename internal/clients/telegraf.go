@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// TelegrafsAPI is the set of calls we make in controllers that use Telegrafs
+// API.
+type TelegrafsAPI interface {
+	// GetTelegrafByID returns the Telegraf configuration with the given ID.
+	GetTelegrafByID(ctx context.Context, telegrafID string) (*domain.Telegraf, error)
+
+	// CreateTelegraf creates a new Telegraf configuration.
+	CreateTelegraf(ctx context.Context, telegraf domain.TelegrafRequest) (*domain.Telegraf, error)
+
+	// UpdateTelegraf replaces the Telegraf configuration with the given ID.
+	UpdateTelegraf(ctx context.Context, telegrafID string, telegraf domain.TelegrafRequest) (*domain.Telegraf, error)
+
+	// DeleteTelegrafWithID deletes the Telegraf configuration with the given
+	// ID.
+	DeleteTelegrafWithID(ctx context.Context, telegrafID string) error
+}
+
+// NewTelegrafsAPI returns a TelegrafsAPI that uses the given client.
+func NewTelegrafsAPI(c *domain.ClientWithResponses) TelegrafsAPI {
+	return &telegrafsAPI{client: c}
+}
+
+type telegrafsAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *telegrafsAPI) GetTelegrafByID(ctx context.Context, telegrafID string) (*domain.Telegraf, error) {
+	// The configuration is returned in TOML format unless JSON is asked for.
+	accept := domain.GetTelegrafsIDParamsAccept("application/json")
+	resp, err := c.client.GetTelegrafsIDWithResponse(ctx, telegrafID, &domain.GetTelegrafsIDParams{Accept: &accept})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *telegrafsAPI) CreateTelegraf(ctx context.Context, telegraf domain.TelegrafRequest) (*domain.Telegraf, error) {
+	resp, err := c.client.PostTelegrafsWithResponse(ctx, &domain.PostTelegrafsParams{}, domain.PostTelegrafsJSONRequestBody(telegraf))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON201, nil
+}
+
+func (c *telegrafsAPI) UpdateTelegraf(ctx context.Context, telegrafID string, telegraf domain.TelegrafRequest) (*domain.Telegraf, error) {
+	resp, err := c.client.PutTelegrafsIDWithResponse(ctx, telegrafID, &domain.PutTelegrafsIDParams{}, domain.PutTelegrafsIDJSONRequestBody(telegraf))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+func (c *telegrafsAPI) DeleteTelegrafWithID(ctx context.Context, telegrafID string) error {
+	resp, err := c.client.DeleteTelegrafsIDWithResponse(ctx, telegrafID, &domain.DeleteTelegrafsIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+// MockTelegrafsAPI mocks TelegrafsAPI.
+type MockTelegrafsAPI struct {
+	GetTelegrafByIDFn      func(ctx context.Context, telegrafID string) (*domain.Telegraf, error)
+	CreateTelegrafFn       func(ctx context.Context, telegraf domain.TelegrafRequest) (*domain.Telegraf, error)
+	UpdateTelegrafFn       func(ctx context.Context, telegrafID string, telegraf domain.TelegrafRequest) (*domain.Telegraf, error)
+	DeleteTelegrafWithIDFn func(ctx context.Context, telegrafID string) error
+}
+
+// GetTelegrafByID calls GetTelegrafByIDFn.
+func (m *MockTelegrafsAPI) GetTelegrafByID(ctx context.Context, telegrafID string) (*domain.Telegraf, error) {
+	return m.GetTelegrafByIDFn(ctx, telegrafID)
+}
+
+// CreateTelegraf calls CreateTelegrafFn.
+func (m *MockTelegrafsAPI) CreateTelegraf(ctx context.Context, telegraf domain.TelegrafRequest) (*domain.Telegraf, error) {
+	return m.CreateTelegrafFn(ctx, telegraf)
+}
+
+// UpdateTelegraf calls UpdateTelegrafFn.
+func (m *MockTelegrafsAPI) UpdateTelegraf(ctx context.Context, telegrafID string, telegraf domain.TelegrafRequest) (*domain.Telegraf, error) {
+	return m.UpdateTelegrafFn(ctx, telegrafID, telegraf)
+}
+
+// DeleteTelegrafWithID calls DeleteTelegrafWithIDFn.
+func (m *MockTelegrafsAPI) DeleteTelegrafWithID(ctx context.Context, telegrafID string) error {
+	return m.DeleteTelegrafWithIDFn(ctx, telegrafID)
+}
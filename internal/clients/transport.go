@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	errGetCA            = "cannot get CA bundle"
+	errGetCAConfigMap   = "cannot get ConfigMap with the CA bundle"
+	errCAKeyMissing     = "key of the CA bundle is missing in the ConfigMap"
+	errParseCA          = "CA bundle does not contain any PEM encoded certificate"
+	errNoClientKeyPair  = "clientCertSecretRef and clientKeySecretRef have to be given together"
+	errGetClientCert    = "cannot get client certificate"
+	errGetClientKey     = "cannot get client key"
+	errParseClientCerts = "cannot parse client certificate and key"
+	errParseProxyURL    = "cannot parse proxy URL"
+	errNoProxyUsername  = "usernameSecretRef of the proxy is required if passwordSecretRef is given"
+	errGetProxyUsername = "cannot get proxy username"
+	errGetProxyPassword = "cannot get proxy password"
+	errGetTransportRef  = "cannot get Secret referenced by the TLS or proxy settings"
+)
+
+// transports caches the transport of every ProviderConfig so that its
+// connection pool is reused across reconciliations instead of opening new
+// connections on every Connect.
+var transports = &transportCache{entries: map[types.UID]cachedTransport{}}
+
+// A cachedTransport is a transport built for a specific version of a
+// ProviderConfig and the objects it references.
+type cachedTransport struct {
+	version string
+	rt      http.RoundTripper
+	base    *http.Transport
+}
+
+// transportCache stores the transport of every ProviderConfig by its UID.
+type transportCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]cachedTransport
+}
+
+// get returns the transport of the ProviderConfig with the given UID if it
+// was built for the given version.
+func (c *transportCache) get(uid types.UID, version string) (http.RoundTripper, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uid]
+	if !ok || e.version != version {
+		return nil, false
+	}
+	return e.rt, true
+}
+
+// set stores the given transport and returns the one to use. If another
+// transport was built for the same version in the meantime, that one is kept.
+// The idle connections of the transport that is dropped are closed.
+func (c *transportCache) set(uid types.UID, e cachedTransport) http.RoundTripper {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old, ok := c.entries[uid]
+	if ok && old.version == e.version {
+		e.base.CloseIdleConnections()
+		return old.rt
+	}
+	if ok {
+		old.base.CloseIdleConnections()
+	}
+	c.entries[uid] = e
+	return e.rt
+}
+
+// newTransport returns the HTTP transport to use with the given
+// ProviderConfig. A nil transport means the default one of the InfluxDB
+// client. The transport is reused until the ProviderConfig or one of the
+// objects it references changes.
+func newTransport(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (http.RoundTripper, error) {
+	if pc.Spec.TLS == nil && pc.Spec.HTTP == nil {
+		return nil, nil
+	}
+	// NOTE: A ProviderConfig without a UID does not exist in the API server,
+	// so there is nothing to tell its versions apart.
+	if pc.GetUID() == "" {
+		rt, _, err := buildTransport(ctx, kube, pc)
+		return rt, err
+	}
+	version, err := transportVersion(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	if rt, ok := transports.get(pc.GetUID(), version); ok {
+		return rt, nil
+	}
+	rt, base, err := buildTransport(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	return transports.set(pc.GetUID(), cachedTransport{version: version, rt: rt, base: base}), nil
+}
+
+// transportVersion returns a string that changes whenever the given
+// ProviderConfig or one of the objects its TLS and proxy settings reference
+// changes.
+func transportVersion(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (string, error) {
+	version := strconv.FormatInt(pc.GetGeneration(), 10)
+	var refs []*xpv1.SecretKeySelector
+	if cfg := pc.Spec.TLS; cfg != nil {
+		refs = append(refs, cfg.CASecretRef, cfg.ClientCertSecretRef, cfg.ClientKeySecretRef)
+		if ref := cfg.CAConfigMapRef; ref != nil {
+			cm := &corev1.ConfigMap{}
+			if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+				return "", errors.Wrap(err, errGetCAConfigMap)
+			}
+			version += "/" + cm.GetResourceVersion()
+		}
+	}
+	if pc.Spec.HTTP != nil && pc.Spec.HTTP.Proxy != nil {
+		refs = append(refs, pc.Spec.HTTP.Proxy.UsernameSecretRef, pc.Spec.HTTP.Proxy.PasswordSecretRef)
+	}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return "", errors.Wrap(err, errGetTransportRef)
+		}
+		version += "/" + s.GetResourceVersion()
+	}
+	return version, nil
+}
+
+// buildTransport builds the HTTP transport described by the given
+// ProviderConfig. It returns the underlying *http.Transport, too, so that its
+// idle connections can be closed once it is not used anymore.
+func buildTransport(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (http.RoundTripper, *http.Transport, error) {
+	// NOTE: The settings other than the TLS and proxy configurations are the
+	// same as the ones of the default transport of the InfluxDB client.
+	t := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	if pc.Spec.TLS != nil {
+		tc, err := newTLSConfig(ctx, kube, pc.Spec.TLS)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.TLSClientConfig = tc
+	}
+	if pc.Spec.HTTP == nil {
+		return t, t, nil
+	}
+	if pc.Spec.HTTP.Proxy != nil {
+		u, err := newProxyURL(ctx, kube, pc.Spec.HTTP.Proxy)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+	if len(pc.Spec.HTTP.Headers) == 0 {
+		return t, t, nil
+	}
+	return &headerTransport{base: t, headers: pc.Spec.HTTP.Headers}, t, nil
+}
+
+// requestTimeout returns the timeout of a single request in seconds.
+func requestTimeout(pc *v1alpha1.ProviderConfig) uint {
+	if pc.Spec.HTTP == nil || pc.Spec.HTTP.TimeoutSeconds == nil {
+		return apihttp.DefaultOptions().HTTPRequestTimeout()
+	}
+	return uint(*pc.Spec.HTTP.TimeoutSeconds)
+}
+
+// newProxyURL returns the URL of the given proxy including the credentials
+// read from the referenced Secrets.
+func newProxyURL(ctx context.Context, kube client.Client, cfg *v1alpha1.ProxyConfig) (*url.URL, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseProxyURL)
+	}
+	if cfg.UsernameSecretRef == nil {
+		if cfg.PasswordSecretRef != nil {
+			return nil, errors.New(errNoProxyUsername)
+		}
+		return u, nil
+	}
+	username, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: cfg.UsernameSecretRef})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetProxyUsername)
+	}
+	if cfg.PasswordSecretRef == nil {
+		u.User = url.User(string(username))
+		return u, nil
+	}
+	password, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: cfg.PasswordSecretRef})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetProxyPassword)
+	}
+	u.User = url.UserPassword(string(username), string(password))
+	return u, nil
+}
+
+// headerTransport sets the given headers on every request before sending it
+// through the base transport.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// NOTE: A RoundTripper must not modify the given request.
+	r := req.Clone(req.Context())
+	for k, v := range t.headers {
+		r.Header.Set(k, v)
+	}
+	return t.base.RoundTrip(r)
+}
+
+// newTLSConfig builds the TLS configuration described by the given settings,
+// reading the CA bundle and the client certificate from the referenced
+// objects.
+func newTLSConfig(ctx context.Context, kube client.Client, cfg *v1alpha1.TLSConfig) (*tls.Config, error) { // nolint:gocyclo
+	tc := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // nolint:gosec
+	}
+	if cfg.ServerName != nil {
+		tc.ServerName = *cfg.ServerName
+	}
+
+	var ca []byte
+	switch {
+	case cfg.CASecretRef != nil:
+		b, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: cfg.CASecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCA)
+		}
+		ca = b
+	case cfg.CAConfigMapRef != nil:
+		ref := cfg.CAConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetCAConfigMap)
+		}
+		s, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, errors.New(errCAKeyMissing)
+		}
+		ca = []byte(s)
+	}
+	if cfg.CASecretRef != nil || cfg.CAConfigMapRef != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New(errParseCA)
+		}
+		tc.RootCAs = pool
+	}
+
+	if (cfg.ClientCertSecretRef == nil) != (cfg.ClientKeySecretRef == nil) {
+		return nil, errors.New(errNoClientKeyPair)
+	}
+	if cfg.ClientCertSecretRef != nil {
+		cert, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: cfg.ClientCertSecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetClientCert)
+		}
+		key, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: cfg.ClientKeySecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetClientKey)
+		}
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseClientCerts)
+		}
+		tc.Certificates = []tls.Certificate{pair}
+	}
+	return tc, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// UsersAPI is the set of calls we make in controllers that use Users API.
+type UsersAPI interface {
+	// CreateUser creates a new user.
+	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
+
+	// FindUserByName returns a user found using userName.
+	FindUserByName(ctx context.Context, userName string) (*domain.User, error)
+
+	// UpdateUser updates a user.
+	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
+
+	// UpdateUserPasswordWithID updates the password of the user with the given
+	// ID.
+	UpdateUserPasswordWithID(ctx context.Context, userID string, password string) error
+
+	// DeleteUserWithID deletes the user with the given ID.
+	DeleteUserWithID(ctx context.Context, userID string) error
+}
+
+// MockUsersAPI mocks UsersAPI.
+type MockUsersAPI struct {
+	CreateUserFn               func(ctx context.Context, user *domain.User) (*domain.User, error)
+	FindUserByNameFn           func(ctx context.Context, userName string) (*domain.User, error)
+	UpdateUserFn               func(ctx context.Context, user *domain.User) (*domain.User, error)
+	UpdateUserPasswordWithIDFn func(ctx context.Context, userID string, password string) error
+	DeleteUserWithIDFn         func(ctx context.Context, userID string) error
+}
+
+// CreateUser calls CreateUserFn.
+func (m *MockUsersAPI) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	return m.CreateUserFn(ctx, user)
+}
+
+// FindUserByName calls FindUserByNameFn.
+func (m *MockUsersAPI) FindUserByName(ctx context.Context, userName string) (*domain.User, error) {
+	return m.FindUserByNameFn(ctx, userName)
+}
+
+// UpdateUser calls UpdateUserFn.
+func (m *MockUsersAPI) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	return m.UpdateUserFn(ctx, user)
+}
+
+// UpdateUserPasswordWithID calls UpdateUserPasswordWithIDFn.
+func (m *MockUsersAPI) UpdateUserPasswordWithID(ctx context.Context, userID string, password string) error {
+	return m.UpdateUserPasswordWithIDFn(ctx, userID, password)
+}
+
+// DeleteUserWithID calls DeleteUserWithIDFn.
+func (m *MockUsersAPI) DeleteUserWithID(ctx context.Context, userID string) error {
+	return m.DeleteUserWithIDFn(ctx, userID)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Variable argument types.
+const (
+	VariableArgumentsTypeQuery    = "query"
+	VariableArgumentsTypeConstant = "constant"
+	VariableArgumentsTypeMap      = "map"
+)
+
+// Variable is the variable model of InfluxDB API with typed arguments. The
+// generated domain.Variable cannot be used for serialization since the
+// format of its arguments depends on their type.
+type Variable struct {
+	domain.Variable
+
+	Arguments VariableArguments `json:"arguments"`
+}
+
+// VariableArguments are the arguments of a variable. Only the values of the
+// given type are used.
+type VariableArguments struct {
+	Type     string
+	Query    *QueryVariableValues
+	Constant []string
+	Map      map[string]string
+}
+
+// QueryVariableValues are the values of a query variable.
+type QueryVariableValues struct {
+	Query    string `json:"query"`
+	Language string `json:"language"`
+}
+
+type rawVariableArguments struct {
+	Type   string          `json:"type"`
+	Values json.RawMessage `json:"values"`
+}
+
+// MarshalJSON encodes the values of the given type.
+func (a VariableArguments) MarshalJSON() ([]byte, error) {
+	var v interface{}
+	switch a.Type {
+	case VariableArgumentsTypeQuery:
+		v = a.Query
+	case VariableArgumentsTypeConstant:
+		v = a.Constant
+	case VariableArgumentsTypeMap:
+		v = a.Map
+	}
+	values, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(rawVariableArguments{Type: a.Type, Values: values})
+}
+
+// UnmarshalJSON decodes the values of the given type.
+func (a *VariableArguments) UnmarshalJSON(b []byte) error {
+	raw := rawVariableArguments{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*a = VariableArguments{Type: raw.Type}
+	if len(raw.Values) == 0 {
+		return nil
+	}
+	switch raw.Type {
+	case VariableArgumentsTypeQuery:
+		return json.Unmarshal(raw.Values, &a.Query)
+	case VariableArgumentsTypeConstant:
+		return json.Unmarshal(raw.Values, &a.Constant)
+	case VariableArgumentsTypeMap:
+		return json.Unmarshal(raw.Values, &a.Map)
+	}
+	return nil
+}
+
+// VariablesAPI is the set of calls we make in controllers that use Variables
+// API.
+type VariablesAPI interface {
+	// GetVariableByID returns the variable with the given ID.
+	GetVariableByID(ctx context.Context, variableID string) (*Variable, error)
+
+	// CreateVariable creates a new variable.
+	CreateVariable(ctx context.Context, variable *Variable) (*Variable, error)
+
+	// UpdateVariable replaces the variable that has the ID of the given
+	// variable.
+	UpdateVariable(ctx context.Context, variable *Variable) (*Variable, error)
+
+	// DeleteVariableWithID deletes the variable with the given ID.
+	DeleteVariableWithID(ctx context.Context, variableID string) error
+}
+
+// NewVariablesAPI returns a VariablesAPI that uses the given client. The
+// generated client cannot decode variables, so the request and response
+// bodies are handled here.
+func NewVariablesAPI(c *domain.ClientWithResponses) VariablesAPI {
+	return &variablesAPI{client: c}
+}
+
+type variablesAPI struct {
+	client *domain.ClientWithResponses
+}
+
+func (c *variablesAPI) GetVariableByID(ctx context.Context, variableID string) (*Variable, error) {
+	resp, err := c.client.GetVariablesIDWithResponse(ctx, variableID, &domain.GetVariablesIDParams{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON404 != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSON404, http.StatusNotFound)
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeVariable(resp.Body)
+}
+
+func (c *variablesAPI) CreateVariable(ctx context.Context, variable *Variable) (*Variable, error) {
+	b, err := json.Marshal(variable)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PostVariablesWithBodyWithResponse(ctx, &domain.PostVariablesParams{}, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeVariable(resp.Body)
+}
+
+func (c *variablesAPI) UpdateVariable(ctx context.Context, variable *Variable) (*Variable, error) {
+	b, err := json.Marshal(variable)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PutVariablesIDWithBodyWithResponse(ctx, *variable.Id, &domain.PutVariablesIDParams{}, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSONDefault != nil {
+		return nil, domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return decodeVariable(resp.Body)
+}
+
+func (c *variablesAPI) DeleteVariableWithID(ctx context.Context, variableID string) error {
+	resp, err := c.client.DeleteVariablesIDWithResponse(ctx, variableID, &domain.DeleteVariablesIDParams{})
+	if err != nil {
+		return err
+	}
+	if resp.JSONDefault != nil {
+		return domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return nil
+}
+
+func decodeVariable(body []byte) (*Variable, error) {
+	out := &Variable{}
+	return out, json.Unmarshal(body, out)
+}
+
+// MockVariablesAPI mocks VariablesAPI.
+type MockVariablesAPI struct {
+	GetVariableByIDFn      func(ctx context.Context, variableID string) (*Variable, error)
+	CreateVariableFn       func(ctx context.Context, variable *Variable) (*Variable, error)
+	UpdateVariableFn       func(ctx context.Context, variable *Variable) (*Variable, error)
+	DeleteVariableWithIDFn func(ctx context.Context, variableID string) error
+}
+
+// GetVariableByID calls GetVariableByIDFn.
+func (m *MockVariablesAPI) GetVariableByID(ctx context.Context, variableID string) (*Variable, error) {
+	return m.GetVariableByIDFn(ctx, variableID)
+}
+
+// CreateVariable calls CreateVariableFn.
+func (m *MockVariablesAPI) CreateVariable(ctx context.Context, variable *Variable) (*Variable, error) {
+	return m.CreateVariableFn(ctx, variable)
+}
+
+// UpdateVariable calls UpdateVariableFn.
+func (m *MockVariablesAPI) UpdateVariable(ctx context.Context, variable *Variable) (*Variable, error) {
+	return m.UpdateVariableFn(ctx, variable)
+}
+
+// DeleteVariableWithID calls DeleteVariableWithIDFn.
+func (m *MockVariablesAPI) DeleteVariableWithID(ctx context.Context, variableID string) error {
+	return m.DeleteVariableWithIDFn(ctx, variableID)
+}
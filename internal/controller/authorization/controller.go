@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotAuthorization    = "managed resource is not an Authorization custom resource"
+	errFindAuthorization   = "cannot find authorization"
+	errCreateAuthorization = "cannot create authorization"
+	errUpdateAuthorization = "cannot update authorization"
+	errDeleteAuthorization = "cannot delete authorization"
+)
+
+// Setup adds a controller that reconciles Authorization managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.AuthorizationGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AuthorizationGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Authorization{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: cs.Client.AuthorizationsAPI(), update: cs.WithResponses}, nil
+}
+
+type external struct {
+	api    clients.AuthorizationsAPI
+	update clients.AuthorizationUpdateAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Authorization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAuthorization)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	auths, err := c.api.FindAuthorizationsByOrgID(ctx, pointer.StringDeref(cr.Spec.ForProvider.OrgID, ""))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errFindAuthorization)
+	}
+	auth := FindAuthorization(auths, meta.GetExternalName(cr))
+	if auth == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = GenerateAuthorizationObservation(auth)
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.AuthorizationUpdateRequestStatusActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.AuthorizationUpdateRequestStatusInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+	li := LateInitialize(&cr.Spec.ForProvider, auth)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        IsUpToDate(cr.Spec.ForProvider, auth),
+		ConnectionDetails:       GetConnectionDetails(auth),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Authorization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAuthorization)
+	}
+
+	auth, err := c.api.CreateAuthorization(ctx, GenerateAuthorization(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthorization)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(auth.Id, ""))
+	return managed.ExternalCreation{ConnectionDetails: GetConnectionDetails(auth)}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Authorization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAuthorization)
+	}
+	// Only the description and the status of an authorization can be changed
+	// after creation.
+	resp, err := c.update.PatchAuthorizationsIDWithResponse(ctx, meta.GetExternalName(cr), &domain.PatchAuthorizationsIDParams{}, domain.PatchAuthorizationsIDJSONRequestBody(GenerateAuthorizationUpdateRequest(cr.Spec.ForProvider)))
+	if err == nil && resp.JSONDefault != nil {
+		err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthorization)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Authorization)
+	if !ok {
+		return errors.New(errNotAuthorization)
+	}
+	err := c.api.DeleteAuthorizationWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteAuthorization)
+}
@@ -0,0 +1,435 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func withExternalName(n string) *v1alpha1.Authorization {
+	cr := &v1alpha1.Authorization{}
+	meta.SetExternalName(cr, n)
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	active := domain.AuthorizationUpdateRequestStatusActive
+	type args struct {
+		mg  resource.Managed
+		api clients.AuthorizationsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotAuthorization),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Authorization{},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FindFailed": {
+			args: args{
+				mg: withExternalName("id"),
+				api: &clients.MockAuthorizationsAPI{
+					FindAuthorizationsByOrgIDFn: func(_ context.Context, _ string) (*[]domain.Authorization, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errFindAuthorization),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: withExternalName("id"),
+				api: &clients.MockAuthorizationsAPI{
+					FindAuthorizationsByOrgIDFn: func(_ context.Context, _ string) (*[]domain.Authorization, error) {
+						return &[]domain.Authorization{{Id: pointer.String("other")}}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDateWithToken": {
+			args: args{
+				mg: withExternalName("id"),
+				api: &clients.MockAuthorizationsAPI{
+					FindAuthorizationsByOrgIDFn: func(_ context.Context, _ string) (*[]domain.Authorization, error) {
+						return &[]domain.Authorization{{
+							Id:    pointer.String("id"),
+							Token: pointer.String("secret"),
+							AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+								Status: &active,
+							},
+						}}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyToken: []byte("secret"),
+					},
+				},
+			},
+		},
+		"UpdateNeeded": {
+			args: args{
+				mg: &v1alpha1.Authorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{meta.AnnotationKeyExternalName: "id"},
+					},
+					Spec: v1alpha1.AuthorizationSpec{
+						ForProvider: v1alpha1.AuthorizationParameters{
+							Status: pointer.String("inactive"),
+						},
+					},
+				},
+				api: &clients.MockAuthorizationsAPI{
+					FindAuthorizationsByOrgIDFn: func(_ context.Context, _ string) (*[]domain.Authorization, error) {
+						return &[]domain.Authorization{{
+							Id: pointer.String("id"),
+							AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+								Status: &active,
+							},
+						}}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				mg: &v1alpha1.Authorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{meta.AnnotationKeyExternalName: "id"},
+					},
+					Spec: v1alpha1.AuthorizationSpec{
+						ForProvider: v1alpha1.AuthorizationParameters{
+							Description: pointer.String("grafana"),
+							Status:      pointer.String("active"),
+						},
+					},
+				},
+				api: &clients.MockAuthorizationsAPI{
+					FindAuthorizationsByOrgIDFn: func(_ context.Context, _ string) (*[]domain.Authorization, error) {
+						return &[]domain.Authorization{{
+							Id: pointer.String("id"),
+							AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+								Description: pointer.String("chronograf"),
+								Status:      &active,
+							},
+						}}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.AuthorizationsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAuthorization),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Authorization{},
+				api: &clients.MockAuthorizationsAPI{
+					CreateAuthorizationFn: func(_ context.Context, _ *domain.Authorization) (*domain.Authorization, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg:  &v1alpha1.Authorization{},
+				err: errors.Wrap(errBoom, errCreateAuthorization),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Authorization{
+					Spec: v1alpha1.AuthorizationSpec{
+						ForProvider: v1alpha1.AuthorizationParameters{
+							OrgID: pointer.String("org"),
+							Permissions: []v1alpha1.Permission{{
+								Action:   "read",
+								Resource: v1alpha1.PermissionResource{Type: "buckets"},
+							}},
+						},
+					},
+				},
+				api: &clients.MockAuthorizationsAPI{
+					CreateAuthorizationFn: func(_ context.Context, a *domain.Authorization) (*domain.Authorization, error) {
+						if a.Permissions == nil || len(*a.Permissions) != 1 {
+							t.Errorf("creation call has to include the permissions")
+						}
+						return &domain.Authorization{Id: pointer.String("id"), Token: pointer.String("secret")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Authorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{meta.AnnotationKeyExternalName: "id"},
+					},
+					Spec: v1alpha1.AuthorizationSpec{
+						ForProvider: v1alpha1.AuthorizationParameters{
+							OrgID: pointer.String("org"),
+							Permissions: []v1alpha1.Permission{{
+								Action:   "read",
+								Resource: v1alpha1.PermissionResource{Type: "buckets"},
+							}},
+						},
+					},
+				},
+				cre: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						keyToken: []byte("secret"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg     resource.Managed
+		update clients.AuthorizationUpdateAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotAuthorization),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Authorization{},
+				update: &clients.MockAuthorizationUpdateAPI{
+					PatchAuthorizationsIDWithResponseFn: func(_ context.Context, _ string, _ *domain.PatchAuthorizationsIDParams, _ domain.PatchAuthorizationsIDJSONRequestBody) (*domain.PatchAuthorizationsIDResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateAuthorization),
+			},
+		},
+		"UpdateDescriptionAndStatus": {
+			args: args{
+				mg: &v1alpha1.Authorization{
+					Spec: v1alpha1.AuthorizationSpec{
+						ForProvider: v1alpha1.AuthorizationParameters{
+							Description: pointer.String("grafana"),
+							Status:      pointer.String("inactive"),
+						},
+					},
+				},
+				update: &clients.MockAuthorizationUpdateAPI{
+					PatchAuthorizationsIDWithResponseFn: func(_ context.Context, _ string, _ *domain.PatchAuthorizationsIDParams, body domain.PatchAuthorizationsIDJSONRequestBody) (*domain.PatchAuthorizationsIDResponse, error) {
+						if pointer.StringDeref(body.Description, "") != "grafana" || body.Status == nil || *body.Status != domain.AuthorizationUpdateRequestStatusInactive {
+							t.Errorf("update call has to use the desired description and status")
+						}
+						return &domain.PatchAuthorizationsIDResponse{JSON200: &domain.Authorization{}}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{update: tc.args.update}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.AuthorizationsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotAuthorization),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: withExternalName("testid"),
+				api: &clients.MockAuthorizationsAPI{
+					DeleteAuthorizationWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Authorization{},
+				api: &clients.MockAuthorizationsAPI{
+					DeleteAuthorizationWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteAuthorization),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	keyToken = "token"
+)
+
+// FindAuthorization returns the authorization with the given ID from the list
+// or nil if it is not found.
+func FindAuthorization(auths *[]domain.Authorization, id string) *domain.Authorization {
+	if auths == nil {
+		return nil
+	}
+	for i := range *auths {
+		if pointer.StringDeref((*auths)[i].Id, "") == id {
+			return &(*auths)[i]
+		}
+	}
+	return nil
+}
+
+// GenerateAuthorizationObservation converts an Authorization response to an
+// observation.
+func GenerateAuthorizationObservation(a *domain.Authorization) v1alpha1.AuthorizationObservation {
+	o := v1alpha1.AuthorizationObservation{
+		ID:     pointer.StringDeref(a.Id, ""),
+		Org:    pointer.StringDeref(a.Org, ""),
+		User:   pointer.StringDeref(a.User, ""),
+		UserID: pointer.StringDeref(a.UserID, ""),
+	}
+	if a.Status != nil {
+		o.Status = string(*a.Status)
+	}
+	if a.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*a.CreatedAt)
+	}
+	if a.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*a.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateAuthorization returns an Authorization model that the InfluxDB API
+// accepts for creation.
+func GenerateAuthorization(params v1alpha1.AuthorizationParameters) *domain.Authorization {
+	out := &domain.Authorization{
+		AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+			Description: params.Description,
+		},
+		OrgID: params.OrgID,
+	}
+	if params.Status != nil {
+		s := domain.AuthorizationUpdateRequestStatus(*params.Status)
+		out.Status = &s
+	}
+	perms := make([]domain.Permission, len(params.Permissions))
+	for i, p := range params.Permissions {
+		perms[i] = domain.Permission{
+			Action: domain.PermissionAction(p.Action),
+			Resource: domain.Resource{
+				Type:  domain.ResourceType(p.Resource.Type),
+				Id:    p.Resource.ID,
+				OrgID: p.Resource.OrgID,
+			},
+		}
+	}
+	out.Permissions = &perms
+	return out
+}
+
+// GenerateAuthorizationUpdateRequest returns an AuthorizationUpdateRequest
+// model that the InfluxDB API accepts for update.
+func GenerateAuthorizationUpdateRequest(params v1alpha1.AuthorizationParameters) domain.AuthorizationUpdateRequest {
+	s := domain.AuthorizationUpdateRequestStatus(pointer.StringDeref(params.Status, string(domain.AuthorizationUpdateRequestStatusActive)))
+	return domain.AuthorizationUpdateRequest{
+		Description: params.Description,
+		Status:      &s,
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.AuthorizationParameters, obs *domain.Authorization) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(params v1alpha1.AuthorizationParameters, obs *domain.Authorization) bool {
+	observed := string(domain.AuthorizationUpdateRequestStatusActive)
+	if obs.Status != nil {
+		observed = string(*obs.Status)
+	}
+	return pointer.StringDeref(params.Status, string(domain.AuthorizationUpdateRequestStatusActive)) == observed &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "")
+}
+
+// GetConnectionDetails returns the connection details of the given
+// authorization. The token is only included if the API returned it.
+func GetConnectionDetails(a *domain.Authorization) managed.ConnectionDetails {
+	if pointer.StringDeref(a.Token, "") == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		keyToken: []byte(*a.Token),
+	}
+}
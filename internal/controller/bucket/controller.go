@@ -29,6 +29,7 @@ import (
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -43,6 +44,8 @@ const (
 	errCreateBucket = "cannot create bucket"
 	errUpdateBucket = "cannot update bucket"
 	errDeleteBucket = "cannot delete bucket"
+	errAttachLabel  = "cannot attach label to bucket"
+	errDetachLabel  = "cannot detach label from bucket"
 )
 
 // Setup adds a controller that reconciles Bucket managed resources.
@@ -57,6 +60,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		resource.ManagedKind(v1alpha1.BucketGroupVersionKind),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
 		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), clients.NewDefaultOrgInitializer(mgr.GetClient())),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -71,15 +75,16 @@ type connector struct {
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cl, err := clients.NewClient(ctx, c.kube, mg)
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create a new client")
 	}
-	return &external{api: cl.BucketsAPI()}, nil
+	return &external{api: cs.Client.BucketsAPI(), labels: cs.WithResponses}, nil
 }
 
 type external struct {
-	api clients.BucketsAPI
+	api    clients.BucketsAPI
+	labels clients.BucketLabelsAPI
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -109,9 +114,11 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotBucket)
 	}
 
-	_, err := c.api.CreateBucket(ctx, GenerateBucket(meta.GetExternalName(cr), cr.Spec.ForProvider))
-
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucket)
+	bucket, err := c.api.CreateBucket(ctx, GenerateBucket(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucket)
+	}
+	return managed.ExternalCreation{}, c.syncLabels(ctx, pointer.StringDeref(bucket.Id, ""), cr.Spec.ForProvider.LabelIDs, nil)
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -121,9 +128,13 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	b := GenerateBucket(meta.GetExternalName(cr), cr.Spec.ForProvider)
 	b.Id = &cr.Status.AtProvider.ID
-	_, err := c.api.UpdateBucket(ctx, b)
-
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucket)
+	if _, err := c.api.UpdateBucket(ctx, b); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucket)
+	}
+	if cr.Spec.ForProvider.LabelIDs == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, c.syncLabels(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider.LabelIDs, GetLabelIDs(cr.Status.AtProvider.Labels))
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -134,3 +145,29 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	// NOTE(muvaf): The call returns nil error if the bucket does not exist.
 	return errors.Wrap(c.api.DeleteBucket(ctx, &domain.Bucket{Id: &cr.Status.AtProvider.ID}), errDeleteBucket)
 }
+
+// syncLabels attaches and detaches labels of the bucket with the given ID until
+// its labels match the desired ones.
+func (c *external) syncLabels(ctx context.Context, bucketID string, desired, observed []string) error {
+	attach, detach := DiffLabels(desired, observed)
+	for _, id := range attach {
+		resp, err := c.labels.PostBucketsIDLabelsWithResponse(ctx, bucketID, &domain.PostBucketsIDLabelsParams{}, domain.PostBucketsIDLabelsJSONRequestBody{LabelID: pointer.String(id)})
+		if err == nil && resp.JSONDefault != nil {
+			err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+		}
+		if err != nil {
+			return errors.Wrap(err, errAttachLabel)
+		}
+	}
+	for _, id := range detach {
+		resp, err := c.labels.DeleteBucketsIDLabelsIDWithResponse(ctx, bucketID, id, &domain.DeleteBucketsIDLabelsIDParams{})
+		// JSON404 is ignored since it means the label is already detached.
+		if err == nil && resp.JSONDefault != nil {
+			err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+		}
+		if err != nil {
+			return errors.Wrap(err, errDetachLabel)
+		}
+	}
+	return nil
+}
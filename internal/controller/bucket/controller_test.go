@@ -19,6 +19,7 @@ package bucket
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -26,6 +27,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 	"github.com/pkg/errors"
 	"k8s.io/utils/pointer"
@@ -179,8 +181,9 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
-		mg  resource.Managed
-		api clients.BucketsAPI
+		mg     resource.Managed
+		api    clients.BucketsAPI
+		labels clients.BucketLabelsAPI
 	}
 	type want struct {
 		err error
@@ -212,11 +215,63 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateBucket),
 			},
 		},
+		"AttachLabels": {
+			args: args{
+				mg: &v1alpha1.Bucket{
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LabelIDs: []string{"l2", "l1"},
+						},
+					},
+				},
+				api: &clients.MockBucketsAPI{
+					CreateBucketFn: func(_ context.Context, _ *domain.Bucket) (*domain.Bucket, error) {
+						return &domain.Bucket{Id: pointer.String("id")}, nil
+					},
+				},
+				labels: &clients.MockBucketLabelsAPI{
+					PostBucketsIDLabelsWithResponseFn: func(_ context.Context, bucketID string, _ *domain.PostBucketsIDLabelsParams, body domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error) {
+						if bucketID != "id" {
+							t.Errorf("attach call has to use the id of the created bucket")
+						}
+						if body.LabelID == nil {
+							t.Errorf("attach call has to include the label id")
+						}
+						return &domain.PostBucketsIDLabelsResponse{}, nil
+					},
+				},
+			},
+		},
+		"AttachLabelFailed": {
+			args: args{
+				mg: &v1alpha1.Bucket{
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LabelIDs: []string{"l1"},
+						},
+					},
+				},
+				api: &clients.MockBucketsAPI{
+					CreateBucketFn: func(_ context.Context, _ *domain.Bucket) (*domain.Bucket, error) {
+						return &domain.Bucket{Id: pointer.String("id")}, nil
+					},
+				},
+				labels: &clients.MockBucketLabelsAPI{
+					PostBucketsIDLabelsWithResponseFn: func(_ context.Context, _ string, _ *domain.PostBucketsIDLabelsParams, _ domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errAttachLabel),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			e := &external{api: tc.args.api, labels: tc.args.labels}
+			cre, err := e.Create(context.TODO(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
@@ -229,8 +284,9 @@ func TestCreate(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	type args struct {
-		mg  resource.Managed
-		api clients.BucketsAPI
+		mg     resource.Managed
+		api    clients.BucketsAPI
+		labels clients.BucketLabelsAPI
 	}
 	type want struct {
 		err error
@@ -262,11 +318,103 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errUpdateBucket),
 			},
 		},
+		"SyncLabels": {
+			args: args{
+				mg: &v1alpha1.Bucket{
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LabelIDs: []string{"l1", "l2"},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							ID:     "id",
+							Labels: []v1alpha1.LabelObservation{{ID: "l1"}, {ID: "l3"}},
+						},
+					},
+				},
+				api: &clients.MockBucketsAPI{
+					UpdateBucketFn: func(_ context.Context, _ *domain.Bucket) (*domain.Bucket, error) {
+						return &domain.Bucket{}, nil
+					},
+				},
+				labels: &clients.MockBucketLabelsAPI{
+					PostBucketsIDLabelsWithResponseFn: func(_ context.Context, _ string, _ *domain.PostBucketsIDLabelsParams, body domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error) {
+						if pointer.StringDeref(body.LabelID, "") != "l2" {
+							t.Errorf("only the missing label has to be attached")
+						}
+						return &domain.PostBucketsIDLabelsResponse{}, nil
+					},
+					DeleteBucketsIDLabelsIDWithResponseFn: func(_ context.Context, _ string, labelID string, _ *domain.DeleteBucketsIDLabelsIDParams) (*domain.DeleteBucketsIDLabelsIDResponse, error) {
+						if labelID != "l3" {
+							t.Errorf("only the undesired label has to be detached")
+						}
+						return &domain.DeleteBucketsIDLabelsIDResponse{}, nil
+					},
+				},
+			},
+		},
+		"AttachLabelRejected": {
+			args: args{
+				mg: &v1alpha1.Bucket{
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LabelIDs: []string{"l1"},
+						},
+					},
+				},
+				api: &clients.MockBucketsAPI{
+					UpdateBucketFn: func(_ context.Context, _ *domain.Bucket) (*domain.Bucket, error) {
+						return &domain.Bucket{}, nil
+					},
+				},
+				labels: &clients.MockBucketLabelsAPI{
+					PostBucketsIDLabelsWithResponseFn: func(_ context.Context, _ string, _ *domain.PostBucketsIDLabelsParams, _ domain.PostBucketsIDLabelsJSONRequestBody) (*domain.PostBucketsIDLabelsResponse, error) {
+						return &domain.PostBucketsIDLabelsResponse{
+							HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest},
+							JSONDefault:  &domain.Error{Code: domain.ErrorCodeInvalid, Message: "boom"},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(&apihttp.Error{StatusCode: http.StatusBadRequest, Code: string(domain.ErrorCodeInvalid), Message: "boom"}, errAttachLabel),
+			},
+		},
+		"DetachLabelFailed": {
+			args: args{
+				mg: &v1alpha1.Bucket{
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LabelIDs: []string{},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							Labels: []v1alpha1.LabelObservation{{ID: "l1"}},
+						},
+					},
+				},
+				api: &clients.MockBucketsAPI{
+					UpdateBucketFn: func(_ context.Context, _ *domain.Bucket) (*domain.Bucket, error) {
+						return &domain.Bucket{}, nil
+					},
+				},
+				labels: &clients.MockBucketLabelsAPI{
+					DeleteBucketsIDLabelsIDWithResponseFn: func(_ context.Context, _ string, _ string, _ *domain.DeleteBucketsIDLabelsIDParams) (*domain.DeleteBucketsIDLabelsIDResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDetachLabel),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			obs, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			obs, err := (&external{api: tc.args.api, labels: tc.args.labels}).Update(context.TODO(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Update(...): -want, +got:\n%s", diff)
 			}
@@ -32,7 +32,8 @@ import (
 // GenerateBucketObservation converts an Bucket response to an observation.
 func GenerateBucketObservation(b *domain.Bucket) v1alpha1.BucketObservation { // nolint:gocyclo
 	o := v1alpha1.BucketObservation{
-		ID: pointer.StringDeref(b.Id, ""),
+		ID:    pointer.StringDeref(b.Id, ""),
+		OrgID: pointer.StringDeref(b.OrgID, ""),
 	}
 	if b.Type != nil {
 		o.Type = string(*b.Type)
@@ -64,9 +65,9 @@ func GenerateBucketObservation(b *domain.Bucket) v1alpha1.BucketObservation { //
 		}
 	}
 	if b.Labels != nil && len(*b.Labels) != 0 {
-		o.Labels = make([]v1alpha1.Label, len(*b.Labels))
+		o.Labels = make([]v1alpha1.LabelObservation, len(*b.Labels))
 		for i, l := range *b.Labels {
-			o.Labels[i] = v1alpha1.Label{
+			o.Labels[i] = v1alpha1.LabelObservation{
 				ID:    pointer.StringDeref(l.Id, ""),
 				Name:  pointer.StringDeref(l.Name, ""),
 				OrgID: pointer.StringDeref(l.OrgID, ""),
@@ -169,9 +170,50 @@ func IsUpToDate(params v1alpha1.BucketParameters, obs *domain.Bucket) bool {
 		}
 	}
 
+	if params.LabelIDs != nil {
+		attach, detach := DiffLabels(params.LabelIDs, GetLabelIDs(GenerateBucketObservation(obs).Labels))
+		if len(attach) != 0 || len(detach) != 0 {
+			return false
+		}
+	}
+
 	return pointer.StringDeref(obs.Description, "") == pointer.StringDeref(params.Description, "")
 }
 
+// DiffLabels returns the IDs of the labels that need to be attached to and
+// detached from the bucket so that its labels match the desired ones. Both
+// lists are sorted by ID, the same way observed labels are.
+func DiffLabels(desired, observed []string) (attach, detach []string) {
+	current := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		current[id] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		if !current[id] && !want[id] {
+			attach = append(attach, id)
+		}
+		want[id] = true
+	}
+	for _, id := range observed {
+		if !want[id] {
+			detach = append(detach, id)
+		}
+	}
+	sort.Strings(attach)
+	sort.Strings(detach)
+	return attach, detach
+}
+
+// GetLabelIDs returns the IDs of the given labels.
+func GetLabelIDs(labels []v1alpha1.LabelObservation) []string {
+	out := make([]string, len(labels))
+	for i := range labels {
+		out[i] = labels[i].ID
+	}
+	return out
+}
+
 // IsNotFoundFn returns an ErrorIs function that can tell whether the error is
 // of kind NotFound.
 func IsNotFoundFn(name string) resource.ErrorIs {
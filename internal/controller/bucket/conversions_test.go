@@ -146,6 +146,36 @@ func TestIsUpToDate(t *testing.T) {
 		args args
 		want bool
 	}{
+		"LabelMissing": {
+			args: args{
+				params: v1alpha1.BucketParameters{
+					LabelIDs: []string{"l1", "l2"},
+				},
+				obs: &domain.Bucket{
+					Labels: &domain.Labels{{Id: pointer.String("l1")}},
+				},
+			},
+			want: false,
+		},
+		"LabelsMatchInAnyOrder": {
+			args: args{
+				params: v1alpha1.BucketParameters{
+					LabelIDs: []string{"l2", "l1"},
+				},
+				obs: &domain.Bucket{
+					Labels: &domain.Labels{{Id: pointer.String("l1")}, {Id: pointer.String("l2")}},
+				},
+			},
+			want: true,
+		},
+		"LabelsNotManaged": {
+			args: args{
+				obs: &domain.Bucket{
+					Labels: &domain.Labels{{Id: pointer.String("l1")}},
+				},
+			},
+			want: true,
+		},
 		"UpToDate": {
 			args: args{
 				params: v1alpha1.BucketParameters{
@@ -257,3 +287,54 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffLabels(t *testing.T) {
+	type args struct {
+		desired  []string
+		observed []string
+	}
+	type want struct {
+		attach []string
+		detach []string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoDrift": {
+			args: args{
+				desired:  []string{"b", "a"},
+				observed: []string{"a", "b"},
+			},
+		},
+		"AttachAndDetachSorted": {
+			args: args{
+				desired:  []string{"d", "a", "c"},
+				observed: []string{"e", "a", "b"},
+			},
+			want: want{
+				attach: []string{"c", "d"},
+				detach: []string{"b", "e"},
+			},
+		},
+		"DuplicateDesired": {
+			args: args{
+				desired: []string{"a", "a"},
+			},
+			want: want{
+				attach: []string{"a"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffLabels(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("DiffLabels(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("DiffLabels(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
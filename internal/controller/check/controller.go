@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotCheck       = "managed resource is not a Check custom resource"
+	errGetCheck       = "cannot get check"
+	errCreateCheck    = "cannot create check"
+	errUpdateCheck    = "cannot update check"
+	errDeleteCheck    = "cannot delete check"
+	errGenerateCheck  = "cannot generate check"
+	errParseThreshold = "cannot parse the value of threshold at index %d"
+	errNoDeadman      = "deadman has to be given for checks of type deadman"
+)
+
+// Setup adds a controller that reconciles Check managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.CheckGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CheckGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Check{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: clients.NewChecksAPI(rc)}, nil
+}
+
+type external struct {
+	api clients.ChecksAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Check)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCheck)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	check, err := c.api.GetCheckByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetCheck)
+	}
+
+	cr.Status.AtProvider = GenerateCheckObservation(check)
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.TaskStatusTypeActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.TaskStatusTypeInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+	li := LateInitialize(&cr.Spec.ForProvider, check)
+	upToDate, err := IsUpToDate(checkName(cr), cr.Spec.ForProvider, check)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenerateCheck)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        upToDate,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Check)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCheck)
+	}
+	desired, err := GenerateCheck(checkName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateCheck)
+	}
+	check, err := c.api.CreateCheck(ctx, desired)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCheck)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(check.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Check)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCheck)
+	}
+	desired, err := GenerateCheck(checkName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateCheck)
+	}
+	desired.Id = pointer.String(meta.GetExternalName(cr))
+	_, err = c.api.UpdateCheck(ctx, desired)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCheck)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Check)
+	if !ok {
+		return errors.New(errNotCheck)
+	}
+	err := c.api.DeleteCheckWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteCheck)
+}
+
+// checkName returns the name of the check in InfluxDB.
+func checkName(cr *v1alpha1.Check) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
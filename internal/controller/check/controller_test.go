@@ -0,0 +1,857 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const query = `from(bucket: "b") |> range(start: v.timeRangeStart)`
+
+func parseErr(s string) error {
+	_, err := strconv.ParseFloat(s, 64)
+	return err
+}
+
+func greater(v string) v1alpha1.CheckThreshold {
+	return v1alpha1.CheckThreshold{Type: "greater", Level: "CRIT", Value: pointer.String(v)}
+}
+
+// observed returns the check as it'd be returned by the API.
+func observed(t *testing.T, cr *v1alpha1.Check) *clients.Check {
+	c, err := GenerateCheck(checkName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		t.Fatalf("cannot generate check: %s", err)
+	}
+	active := domain.TaskStatusTypeActive
+	c.Id = pointer.String("id")
+	c.Status = &active
+	c.Description = pointer.String("")
+	c.StatusMessageTemplate = pointer.String("")
+	return c
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ChecksAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCheck": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotCheck),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCheck),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDateThreshold": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("90.5")},
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return observed(t, &v1alpha1.Check{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "cpu",
+							},
+							Spec: v1alpha1.CheckSpec{
+								ForProvider: v1alpha1.CheckParameters{
+									OrgID:      pointer.String("org"),
+									Type:       v1alpha1.CheckTypeThreshold,
+									Query:      query,
+									Every:      pointer.String("1m"),
+									Thresholds: []v1alpha1.CheckThreshold{greater("90.50")},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"UpToDateCustom": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeCustom,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return observed(t, &v1alpha1.Check{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "cpu",
+							},
+							Spec: v1alpha1.CheckSpec{
+								ForProvider: v1alpha1.CheckParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.CheckTypeCustom,
+									Query: query,
+									Every: pointer.String("1m"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ThresholdChanged": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("80")},
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return observed(t, &v1alpha1.Check{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "cpu",
+							},
+							Spec: v1alpha1.CheckSpec{
+								ForProvider: v1alpha1.CheckParameters{
+									OrgID:      pointer.String("org"),
+									Type:       v1alpha1.CheckTypeThreshold,
+									Query:      query,
+									Every:      pointer.String("1m"),
+									Thresholds: []v1alpha1.CheckThreshold{greater("90")},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DeadmanChanged": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:   pointer.String("org"),
+							Type:    v1alpha1.CheckTypeDeadman,
+							Query:   query,
+							Every:   pointer.String("1m"),
+							Deadman: &v1alpha1.DeadmanParameters{Level: "CRIT", TimeSince: "90s", ReportZero: pointer.Bool(true)},
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return observed(t, &v1alpha1.Check{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "cpu",
+							},
+							Spec: v1alpha1.CheckSpec{
+								ForProvider: v1alpha1.CheckParameters{
+									OrgID:   pointer.String("org"),
+									Type:    v1alpha1.CheckTypeDeadman,
+									Query:   query,
+									Every:   pointer.String("1m"),
+									Deadman: &v1alpha1.DeadmanParameters{Level: "CRIT", TimeSince: "90s"},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"CustomEveryChanged": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeCustom,
+							Query: query,
+							Every: pointer.String("5m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					GetCheckByIDFn: func(_ context.Context, _ string) (*clients.Check, error) {
+						return observed(t, &v1alpha1.Check{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "cpu",
+							},
+							Spec: v1alpha1.CheckSpec{
+								ForProvider: v1alpha1.CheckParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.CheckTypeCustom,
+									Query: query,
+									Every: pointer.String("1m"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ChecksAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCheck": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotCheck),
+			},
+		},
+		"InvalidThreshold": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("high")},
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("high")},
+						},
+					},
+				},
+				err: errors.Wrap(errors.Wrapf(parseErr("high"), errParseThreshold, 0), errGenerateCheck),
+			},
+		},
+		"NoDeadman": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeDeadman,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeDeadman,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				err: errors.Wrap(errors.New(errNoDeadman), errGenerateCheck),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					CreateCheckFn: func(_ context.Context, _ *clients.Check) (*clients.Check, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateCheck),
+			},
+		},
+		"SuccessThreshold": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("90.5")},
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					CreateCheckFn: func(_ context.Context, c *clients.Check) (*clients.Check, error) {
+						if len(c.Thresholds) != 1 || pointer.Float64Deref(c.Thresholds[0].Value, 0) != 90.5 {
+							t.Errorf("creation call has to include the parsed thresholds")
+						}
+						if pointer.StringDeref(c.Every, "") != "1m" {
+							t.Errorf("creation call has to include the interval of the check")
+						}
+						return &clients.Check{CheckBase: domain.CheckBase{Id: pointer.String("id")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:      pointer.String("org"),
+							Type:       v1alpha1.CheckTypeThreshold,
+							Query:      query,
+							Every:      pointer.String("1m"),
+							Thresholds: []v1alpha1.CheckThreshold{greater("90.5")},
+						},
+					},
+				},
+			},
+		},
+		"SuccessCustom": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeCustom,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					CreateCheckFn: func(_ context.Context, c *clients.Check) (*clients.Check, error) {
+						if !strings.HasPrefix(pointer.StringDeref(c.Query.Text, ""), `option task = {name: "cpu", every: 1m}`) {
+							t.Errorf("custom check query has to start with the task option")
+						}
+						if c.Every != nil {
+							t.Errorf("custom check cannot have every outside of its query")
+						}
+						return &clients.Check{CheckBase: domain.CheckBase{Id: pointer.String("id")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeCustom,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ChecksAPI
+	}
+	type want struct {
+		err error
+		upd managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCheck": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotCheck),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					UpdateCheckFn: func(_ context.Context, _ *clients.Check) (*clients.Check, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateCheck),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID:   pointer.String("org"),
+							Type:    v1alpha1.CheckTypeDeadman,
+							Query:   query,
+							Every:   pointer.String("1m"),
+							Deadman: &v1alpha1.DeadmanParameters{Level: "CRIT", TimeSince: "90s"},
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					UpdateCheckFn: func(_ context.Context, c *clients.Check) (*clients.Check, error) {
+						if pointer.StringDeref(c.Id, "") != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if pointer.StringDeref(c.TimeSince, "") != "90s" {
+							t.Errorf("update call has to include the deadman parameters")
+						}
+						return c, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upd, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ChecksAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCheck": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotCheck),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					DeleteCheckWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					DeleteCheckWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Check{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.CheckSpec{
+						ForProvider: v1alpha1.CheckParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.CheckTypeThreshold,
+							Query: query,
+							Every: pointer.String("1m"),
+						},
+					},
+				},
+				api: &clients.MockChecksAPI{
+					DeleteCheckWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteCheck),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateCheckObservation converts a Check response to an observation.
+func GenerateCheckObservation(c *clients.Check) v1alpha1.CheckObservation {
+	o := v1alpha1.CheckObservation{
+		ID:           pointer.StringDeref(c.Id, ""),
+		OwnerID:      pointer.StringDeref(c.OwnerID, ""),
+		TaskID:       pointer.StringDeref(c.TaskID, ""),
+		LastRunError: pointer.StringDeref(c.LastRunError, ""),
+	}
+	if c.Status != nil {
+		o.Status = string(*c.Status)
+	}
+	if c.LastRunStatus != nil {
+		o.LastRunStatus = string(*c.LastRunStatus)
+	}
+	if c.LatestCompleted != nil {
+		lc := metav1.NewTime(*c.LatestCompleted)
+		o.LatestCompleted = &lc
+	}
+	if c.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*c.CreatedAt)
+	}
+	if c.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*c.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateCheck returns a Check model that the InfluxDB API accepts for
+// creation and update.
+func GenerateCheck(name string, params v1alpha1.CheckParameters) (*clients.Check, error) {
+	out := &clients.Check{
+		CheckBase: domain.CheckBase{
+			Name:        name,
+			Description: params.Description,
+			OrgID:       pointer.StringDeref(params.OrgID, ""),
+			Query:       domain.DashboardQuery{Text: pointer.String(params.Query)},
+		},
+		Type:                  params.Type,
+		StatusMessageTemplate: params.StatusMessageTemplate,
+	}
+	if params.Status != nil {
+		s := domain.TaskStatusType(*params.Status)
+		out.Status = &s
+	}
+	if len(params.Tags) != 0 {
+		out.Tags = make([]clients.CheckTag, len(params.Tags))
+		for i, t := range params.Tags {
+			out.Tags[i] = clients.CheckTag{Key: t.Key, Value: t.Value}
+		}
+	}
+	switch params.Type {
+	case v1alpha1.CheckTypeCustom:
+		// Custom checks are scheduled with the task option in their script.
+		out.Query.Text = pointer.String(clients.GenerateTaskFlux(clients.TaskOptions{
+			Name:   name,
+			Every:  params.Every,
+			Offset: params.Offset,
+		}, params.Query))
+		return out, nil
+	case v1alpha1.CheckTypeThreshold:
+		th, err := generateThresholds(params.Thresholds)
+		if err != nil {
+			return nil, err
+		}
+		out.Thresholds = th
+	case v1alpha1.CheckTypeDeadman:
+		if params.Deadman == nil {
+			return nil, errors.New(errNoDeadman)
+		}
+		l := domain.CheckStatusLevel(params.Deadman.Level)
+		out.Level = &l
+		out.TimeSince = pointer.String(params.Deadman.TimeSince)
+		out.StaleTime = params.Deadman.StaleTime
+		out.ReportZero = params.Deadman.ReportZero
+	}
+	out.Every = params.Every
+	out.Offset = params.Offset
+	return out, nil
+}
+
+func generateThresholds(in []v1alpha1.CheckThreshold) ([]clients.CheckThreshold, error) {
+	out := make([]clients.CheckThreshold, len(in))
+	for i, t := range in {
+		l := domain.CheckStatusLevel(t.Level)
+		out[i] = clients.CheckThreshold{
+			Type:      t.Type,
+			Level:     &l,
+			AllValues: t.AllValues,
+			Within:    t.Within,
+		}
+		var err error
+		if out[i].Value, err = parseFloat(t.Value); err != nil {
+			return nil, errors.Wrapf(err, errParseThreshold, i)
+		}
+		if out[i].Min, err = parseFloat(t.Min); err != nil {
+			return nil, errors.Wrapf(err, errParseThreshold, i)
+		}
+		if out[i].Max, err = parseFloat(t.Max); err != nil {
+			return nil, errors.Wrapf(err, errParseThreshold, i)
+		}
+	}
+	return out, nil
+}
+
+func parseFloat(s *string) (*float64, error) {
+	if s == nil {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(*s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.CheckParameters, obs *clients.Check) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	params.StatusMessageTemplate = li.LateInitializeStringPtr(params.StatusMessageTemplate, obs.StatusMessageTemplate)
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name string, params v1alpha1.CheckParameters, obs *clients.Check) (bool, error) {
+	desired, err := GenerateCheck(name, params)
+	if err != nil {
+		return false, err
+	}
+	return desired.Name == obs.Name &&
+		desired.Type == obs.Type &&
+		pointer.StringDeref(desired.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		strings.TrimSpace(pointer.StringDeref(desired.Query.Text, "")) == strings.TrimSpace(pointer.StringDeref(obs.Query.Text, "")) &&
+		checkStatus(desired.Status) == checkStatus(obs.Status) &&
+		pointer.StringDeref(desired.Every, "") == pointer.StringDeref(obs.Every, "") &&
+		pointer.StringDeref(desired.Offset, "") == pointer.StringDeref(obs.Offset, "") &&
+		pointer.StringDeref(desired.StatusMessageTemplate, "") == pointer.StringDeref(obs.StatusMessageTemplate, "") &&
+		isTagsUpToDate(desired.Tags, obs.Tags) &&
+		isThresholdsUpToDate(desired.Thresholds, obs.Thresholds) &&
+		checkLevel(desired.Level) == checkLevel(obs.Level) &&
+		pointer.StringDeref(desired.TimeSince, "") == pointer.StringDeref(obs.TimeSince, "") &&
+		pointer.StringDeref(desired.StaleTime, "") == pointer.StringDeref(obs.StaleTime, "") &&
+		pointer.BoolDeref(desired.ReportZero, false) == pointer.BoolDeref(obs.ReportZero, false), nil
+}
+
+func isTagsUpToDate(desired, obs []clients.CheckTag) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for i := range desired {
+		if desired[i] != obs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isThresholdsUpToDate(desired, obs []clients.CheckThreshold) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for i := range desired {
+		d, o := desired[i], obs[i]
+		if d.Type != o.Type ||
+			checkLevel(d.Level) != checkLevel(o.Level) ||
+			pointer.BoolDeref(d.AllValues, false) != pointer.BoolDeref(o.AllValues, false) ||
+			pointer.BoolDeref(d.Within, false) != pointer.BoolDeref(o.Within, false) ||
+			!isFloatUpToDate(d.Value, o.Value) ||
+			!isFloatUpToDate(d.Min, o.Min) ||
+			!isFloatUpToDate(d.Max, o.Max) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFloatUpToDate(desired, obs *float64) bool {
+	if desired == nil || obs == nil {
+		return desired == obs
+	}
+	return *desired == *obs
+}
+
+// checkStatus returns the given status with the default of the API filled
+// in.
+func checkStatus(s *domain.TaskStatusType) domain.TaskStatusType {
+	if s == nil {
+		return domain.TaskStatusTypeActive
+	}
+	return *s
+}
+
+func checkLevel(l *domain.CheckStatusLevel) domain.CheckStatusLevel {
+	if l == nil {
+		return ""
+	}
+	return *l
+}
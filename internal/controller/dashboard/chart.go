@@ -0,0 +1,462 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+)
+
+// Kinds of charts as they are given in InfluxDB templates.
+const (
+	chartKindBand               = "band"
+	chartKindGauge              = "gauge"
+	chartKindHeatmap            = "heatmap"
+	chartKindHistogram          = "histogram"
+	chartKindMarkdown           = "markdown"
+	chartKindMosaic             = "mosaic"
+	chartKindScatter            = "scatter"
+	chartKindSingleStat         = "single_stat"
+	chartKindSingleStatPlusLine = "single_stat_plus_line"
+	chartKindTable              = "table"
+	chartKindXY                 = "xy"
+)
+
+// chart is a chart of a dashboard in the format used by InfluxDB templates.
+// The name, position and size of a chart are given by its cell.
+type chart struct {
+	Kind           string       `json:"kind"`
+	Queries        []chartQuery `json:"queries,omitempty"`
+	Colors         []chartColor `json:"colors,omitempty"`
+	Axes           []chartAxis  `json:"axes,omitempty"`
+	Note           string       `json:"note,omitempty"`
+	NoteOnEmpty    bool         `json:"noteOnEmpty,omitempty"`
+	Prefix         string       `json:"prefix,omitempty"`
+	Suffix         string       `json:"suffix,omitempty"`
+	TickPrefix     string       `json:"tickPrefix,omitempty"`
+	TickSuffix     string       `json:"tickSuffix,omitempty"`
+	DecimalPlaces  int32        `json:"decimalPlaces,omitempty"`
+	EnforceDecimal bool         `json:"enforceDecimal,omitempty"`
+	Geom           string       `json:"geom,omitempty"`
+	Position       string       `json:"position,omitempty"`
+	Shade          bool         `json:"shade,omitempty"`
+	HoverDimension string       `json:"hoverDimension,omitempty"`
+	TimeFormat     string       `json:"timeFormat,omitempty"`
+
+	XCol           string   `json:"xCol,omitempty"`
+	YCol           string   `json:"yCol,omitempty"`
+	UpperColumn    string   `json:"upperColumn,omitempty"`
+	MainColumn     string   `json:"mainColumn,omitempty"`
+	LowerColumn    string   `json:"lowerColumn,omitempty"`
+	FillColumns    []string `json:"fillColumns,omitempty"`
+	YSeriesColumns []string `json:"ySeriesColumns,omitempty"`
+	SymbolColumns  []string `json:"symbolColumns,omitempty"`
+	BinSize        float32  `json:"binSize,omitempty"`
+	BinCount       int      `json:"binCount,omitempty"`
+
+	TableOptions *chartTableOptions `json:"tableOptions,omitempty"`
+	FieldOptions []chartFieldOption `json:"fieldOptions,omitempty"`
+
+	LegendColorizeRows         *bool    `json:"legendColorizeRows,omitempty"`
+	LegendHide                 *bool    `json:"legendHide,omitempty"`
+	LegendOpacity              *float32 `json:"legendOpacity,omitempty"`
+	LegendOrientationThreshold *int     `json:"legendOrientationThreshold,omitempty"`
+}
+
+type chartQuery struct {
+	Query string `json:"query"`
+}
+
+type chartColor struct {
+	ID    string  `json:"id,omitempty"`
+	Name  string  `json:"name,omitempty"`
+	Type  string  `json:"type,omitempty"`
+	Hex   string  `json:"hex"`
+	Value float32 `json:"value,omitempty"`
+}
+
+type chartAxis struct {
+	Name   string    `json:"name"`
+	Label  string    `json:"label,omitempty"`
+	Prefix string    `json:"prefix,omitempty"`
+	Suffix string    `json:"suffix,omitempty"`
+	Base   string    `json:"base,omitempty"`
+	Scale  string    `json:"scale,omitempty"`
+	Domain []float32 `json:"domain,omitempty"`
+}
+
+type chartTableOptions struct {
+	VerticalTimeAxis bool   `json:"verticalTimeAxis,omitempty"`
+	SortBy           string `json:"sortBy,omitempty"`
+	Wrapping         string `json:"wrapping,omitempty"`
+	FixFirstColumn   bool   `json:"fixFirstColumn,omitempty"`
+}
+
+type chartFieldOption struct {
+	FieldName   string `json:"fieldName"`
+	DisplayName string `json:"displayName,omitempty"`
+	Visible     bool   `json:"visible,omitempty"`
+}
+
+// viewProperties returns the view properties of the chart in the format of
+// the InfluxDB API.
+func (c chart) viewProperties() (interface{}, error) { // nolint:gocyclo
+	switch strings.ToLower(c.Kind) {
+	case chartKindBand:
+		return domain.BandViewProperties{
+			Type:                       domain.BandViewPropertiesTypeBand,
+			Shape:                      domain.BandViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.colors(),
+			Axes:                       c.axes(),
+			Geom:                       domain.XYGeom(c.Geom),
+			HoverDimension:             (*domain.BandViewPropertiesHoverDimension)(optional(c.HoverDimension)),
+			UpperColumn:                optional(c.UpperColumn),
+			MainColumn:                 optional(c.MainColumn),
+			LowerColumn:                optional(c.LowerColumn),
+			XColumn:                    optional(c.XCol),
+			YColumn:                    optional(c.YCol),
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindGauge:
+		return domain.GaugeViewProperties{
+			Type:              domain.GaugeViewPropertiesTypeGauge,
+			Shape:             domain.GaugeViewPropertiesShapeChronografV2,
+			Queries:           c.queries(),
+			Colors:            c.colors(),
+			Prefix:            c.Prefix,
+			Suffix:            c.Suffix,
+			TickPrefix:        c.TickPrefix,
+			TickSuffix:        c.TickSuffix,
+			DecimalPlaces:     c.decimalPlaces(),
+			Note:              c.Note,
+			ShowNoteWhenEmpty: c.NoteOnEmpty,
+		}, nil
+	case chartKindHeatmap:
+		x, y := c.axis("x"), c.axis("y")
+		return domain.HeatmapViewProperties{
+			Type:                       domain.HeatmapViewPropertiesTypeHeatmap,
+			Shape:                      domain.HeatmapViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.hexColors(),
+			BinSize:                    c.BinSize,
+			XColumn:                    c.XCol,
+			YColumn:                    c.YCol,
+			XDomain:                    domainOf(x),
+			YDomain:                    domainOf(y),
+			XAxisLabel:                 x.Label,
+			YAxisLabel:                 y.Label,
+			XPrefix:                    x.Prefix,
+			YPrefix:                    y.Prefix,
+			XSuffix:                    x.Suffix,
+			YSuffix:                    y.Suffix,
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindHistogram:
+		x := c.axis("x")
+		return domain.HistogramViewProperties{
+			Type:                       domain.HistogramViewPropertiesTypeHistogram,
+			Shape:                      domain.HistogramViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.colors(),
+			FillColumns:                nonNil(c.FillColumns),
+			XColumn:                    c.XCol,
+			XDomain:                    domainOf(x),
+			XAxisLabel:                 x.Label,
+			Position:                   domain.HistogramViewPropertiesPosition(c.Position),
+			BinCount:                   c.BinCount,
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindMarkdown:
+		return domain.MarkdownViewProperties{
+			Type:  domain.MarkdownViewPropertiesTypeMarkdown,
+			Shape: domain.MarkdownViewPropertiesShapeChronografV2,
+			Note:  c.Note,
+		}, nil
+	case chartKindMosaic:
+		x, y := c.axis("x"), c.axis("y")
+		return domain.MosaicViewProperties{
+			Type:                       domain.MosaicViewPropertiesTypeMosaic,
+			Shape:                      domain.MosaicViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.hexColors(),
+			FillColumns:                nonNil(c.FillColumns),
+			XColumn:                    c.XCol,
+			YSeriesColumns:             nonNil(c.YSeriesColumns),
+			XDomain:                    domainOf(x),
+			YDomain:                    domainOf(y),
+			XAxisLabel:                 x.Label,
+			YAxisLabel:                 y.Label,
+			XPrefix:                    x.Prefix,
+			YPrefix:                    y.Prefix,
+			XSuffix:                    x.Suffix,
+			YSuffix:                    y.Suffix,
+			HoverDimension:             (*domain.MosaicViewPropertiesHoverDimension)(optional(c.HoverDimension)),
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindScatter:
+		x, y := c.axis("x"), c.axis("y")
+		return domain.ScatterViewProperties{
+			Type:                       domain.ScatterViewPropertiesTypeScatter,
+			Shape:                      domain.ScatterViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.hexColors(),
+			FillColumns:                nonNil(c.FillColumns),
+			SymbolColumns:              nonNil(c.SymbolColumns),
+			XColumn:                    c.XCol,
+			YColumn:                    c.YCol,
+			XDomain:                    domainOf(x),
+			YDomain:                    domainOf(y),
+			XAxisLabel:                 x.Label,
+			YAxisLabel:                 y.Label,
+			XPrefix:                    x.Prefix,
+			YPrefix:                    y.Prefix,
+			XSuffix:                    x.Suffix,
+			YSuffix:                    y.Suffix,
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindSingleStat:
+		return domain.SingleStatViewProperties{
+			Type:              domain.SingleStatViewPropertiesTypeSingleStat,
+			Shape:             domain.SingleStatViewPropertiesShapeChronografV2,
+			Queries:           c.queries(),
+			Colors:            c.colors(),
+			Prefix:            c.Prefix,
+			Suffix:            c.Suffix,
+			TickPrefix:        c.TickPrefix,
+			TickSuffix:        c.TickSuffix,
+			DecimalPlaces:     c.decimalPlaces(),
+			Note:              c.Note,
+			ShowNoteWhenEmpty: c.NoteOnEmpty,
+		}, nil
+	case chartKindSingleStatPlusLine:
+		return domain.LinePlusSingleStatProperties{
+			Type:                       domain.LinePlusSingleStatPropertiesTypeLinePlusSingleStat,
+			Shape:                      domain.LinePlusSingleStatPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.colors(),
+			Axes:                       c.axes(),
+			Position:                   domain.LinePlusSingleStatPropertiesPosition(c.Position),
+			ShadeBelow:                 &c.Shade,
+			HoverDimension:             (*domain.LinePlusSingleStatPropertiesHoverDimension)(optional(c.HoverDimension)),
+			XColumn:                    optional(c.XCol),
+			YColumn:                    optional(c.YCol),
+			Prefix:                     c.Prefix,
+			Suffix:                     c.Suffix,
+			DecimalPlaces:              c.decimalPlaces(),
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	case chartKindTable:
+		p := domain.TableViewProperties{
+			Type:              domain.TableViewPropertiesTypeTable,
+			Shape:             domain.TableViewPropertiesShapeChronografV2,
+			Queries:           c.queries(),
+			Colors:            c.colors(),
+			DecimalPlaces:     c.decimalPlaces(),
+			FieldOptions:      c.fieldOptions(),
+			TimeFormat:        c.TimeFormat,
+			Note:              c.Note,
+			ShowNoteWhenEmpty: c.NoteOnEmpty,
+		}
+		if o := c.TableOptions; o != nil {
+			p.TableOptions.VerticalTimeAxis = &o.VerticalTimeAxis
+			p.TableOptions.FixFirstColumn = &o.FixFirstColumn
+			p.TableOptions.Wrapping = (*domain.TableViewPropertiesTableOptionsWrapping)(optional(o.Wrapping))
+			if o.SortBy != "" {
+				p.TableOptions.SortBy = &domain.RenamableField{InternalName: &o.SortBy}
+			}
+		}
+		return p, nil
+	case chartKindXY:
+		return domain.XYViewProperties{
+			Type:                       domain.XYViewPropertiesTypeXy,
+			Shape:                      domain.XYViewPropertiesShapeChronografV2,
+			Queries:                    c.queries(),
+			Colors:                     c.colors(),
+			Axes:                       c.axes(),
+			Geom:                       domain.XYGeom(c.Geom),
+			Position:                   domain.XYViewPropertiesPosition(c.Position),
+			ShadeBelow:                 &c.Shade,
+			HoverDimension:             (*domain.XYViewPropertiesHoverDimension)(optional(c.HoverDimension)),
+			XColumn:                    optional(c.XCol),
+			YColumn:                    optional(c.YCol),
+			TimeFormat:                 optional(c.TimeFormat),
+			Note:                       c.Note,
+			ShowNoteWhenEmpty:          c.NoteOnEmpty,
+			LegendColorizeRows:         c.LegendColorizeRows,
+			LegendHide:                 c.LegendHide,
+			LegendOpacity:              c.LegendOpacity,
+			LegendOrientationThreshold: c.LegendOrientationThreshold,
+		}, nil
+	}
+	return nil, errors.Errorf(errUnknownChartKind, c.Kind)
+}
+
+func (c chart) queries() []domain.DashboardQuery {
+	out := make([]domain.DashboardQuery, len(c.Queries))
+	for i := range c.Queries {
+		out[i] = domain.DashboardQuery{
+			Text:     &c.Queries[i].Query,
+			EditMode: queryEditModeAdvanced(),
+		}
+	}
+	return out
+}
+
+func (c chart) colors() []domain.DashboardColor {
+	out := make([]domain.DashboardColor, len(c.Colors))
+	for i, cl := range c.Colors {
+		out[i] = domain.DashboardColor{
+			Id:    cl.ID,
+			Name:  cl.Name,
+			Type:  domain.DashboardColorType(cl.Type),
+			Hex:   cl.Hex,
+			Value: cl.Value,
+		}
+	}
+	return out
+}
+
+// hexColors returns the colors of charts that only take a color scheme.
+func (c chart) hexColors() []string {
+	out := make([]string, len(c.Colors))
+	for i, cl := range c.Colors {
+		out[i] = cl.Hex
+	}
+	return out
+}
+
+func (c chart) decimalPlaces() domain.DecimalPlaces {
+	return domain.DecimalPlaces{
+		Digits:     &c.DecimalPlaces,
+		IsEnforced: &c.EnforceDecimal,
+	}
+}
+
+func (c chart) fieldOptions() []domain.RenamableField {
+	out := make([]domain.RenamableField, len(c.FieldOptions))
+	for i := range c.FieldOptions {
+		o := &c.FieldOptions[i]
+		out[i] = domain.RenamableField{
+			InternalName: &o.FieldName,
+			DisplayName:  &o.DisplayName,
+			Visible:      &o.Visible,
+		}
+	}
+	return out
+}
+
+// axis returns the axis with the given name or an empty one.
+func (c chart) axis(name string) chartAxis {
+	for _, a := range c.Axes {
+		if a.Name == name {
+			return a
+		}
+	}
+	return chartAxis{Name: name}
+}
+
+func (c chart) axes() domain.Axes {
+	return domain.Axes{
+		X: viewAxis(c.axis("x")),
+		Y: viewAxis(c.axis("y")),
+	}
+}
+
+func viewAxis(a chartAxis) domain.Axis {
+	bounds := []string{"", ""}
+	if len(a.Domain) == 2 {
+		bounds = []string{
+			strconv.FormatFloat(float64(a.Domain[0]), 'f', -1, 32),
+			strconv.FormatFloat(float64(a.Domain[1]), 'f', -1, 32),
+		}
+	}
+	return domain.Axis{
+		Bounds: &bounds,
+		Label:  &a.Label,
+		Prefix: &a.Prefix,
+		Suffix: &a.Suffix,
+		Base:   (*domain.AxisBase)(&a.Base),
+		Scale:  (*domain.AxisScale)(optional(a.Scale)),
+	}
+}
+
+func domainOf(a chartAxis) []float32 {
+	if a.Domain == nil {
+		return []float32{}
+	}
+	return a.Domain
+}
+
+func queryEditModeAdvanced() *domain.QueryEditMode {
+	m := domain.QueryEditModeAdvanced
+	return &m
+}
+
+// optional returns nil for an empty string.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nonNil returns an empty list instead of nil.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotDashboard         = "managed resource is not a Dashboard custom resource"
+	errGetDashboard         = "cannot get dashboard"
+	errCreateDashboard      = "cannot create dashboard"
+	errUpdateDashboard      = "cannot update dashboard"
+	errDeleteDashboard      = "cannot delete dashboard"
+	errCreateCell           = "cannot create cell %s"
+	errUpdateCell           = "cannot update cell %s"
+	errGetCellView          = "cannot get view of cell %s"
+	errUpdateCellView       = "cannot update view of cell %s"
+	errDeleteCell           = "cannot delete cell %s"
+	errNoProperties         = "either properties or propertiesConfigMapRef has to be given for cell %s"
+	errGetConfigMap         = "cannot get ConfigMap with the chart of cell %s"
+	errPropertiesKeyMissing = "referenced key does not exist in the ConfigMap of cell %s"
+	errInvalidChart         = "cannot parse the chart of cell %s"
+	errUnknownChartKind     = "unknown chart kind %q"
+)
+
+// Setup adds a controller that reconciles Dashboard managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.DashboardGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DashboardGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Dashboard{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewDashboardsAPI(rc)}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.DashboardsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDashboard)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := c.api.GetDashboardByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetDashboard)
+	}
+	// The cells are not read when only the deletion is left since their
+	// ConfigMaps are often gone together with the resource.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	desired, err := c.getCells(ctx, cr.Spec.ForProvider.Cells)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	applied := cr.Status.AtProvider.Cells
+	views, err := c.getViews(ctx, d, applied)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = GenerateDashboardObservation(d)
+	cr.Status.AtProvider.Cells = applied
+	cr.SetConditions(v1.Available())
+	li := LateInitialize(&cr.Spec.ForProvider, d)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        IsUpToDate(dashboardName(cr), cr.Spec.ForProvider, desired, applied, d, views),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDashboard)
+	}
+	// Cells are created in the first update since the dashboard will not be
+	// up to date until they exist.
+	d, err := c.api.CreateDashboard(ctx, GenerateCreateDashboardRequest(dashboardName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDashboard)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(d.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDashboard)
+	}
+	id := meta.GetExternalName(cr)
+	d, err := c.api.GetDashboardByID(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDashboard)
+	}
+	desired, err := c.getCells(ctx, cr.Spec.ForProvider.Cells)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	views, err := c.getViews(ctx, d, cr.Status.AtProvider.Cells)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if dashboardName(cr) != d.Name || pointer.StringDeref(cr.Spec.ForProvider.Description, "") != pointer.StringDeref(d.Description, "") {
+		if err := c.api.UpdateDashboard(ctx, id, dashboardName(cr), cr.Spec.ForProvider.Description); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDashboard)
+		}
+	}
+
+	applied := make([]v1alpha1.DashboardCellObservation, 0, len(desired))
+	keep := map[string]bool{}
+	for _, dc := range desired {
+		var obs *domain.Cell
+		if a := FindAppliedCell(cr.Status.AtProvider.Cells, dc.Name); a != nil {
+			obs = FindCell(d.Cells, a.ID)
+		}
+		switch {
+		case obs == nil:
+			cell, err := c.api.CreateCell(ctx, id, GenerateCreateCell(dc))
+			if err != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errCreateCell, dc.Name)
+			}
+			obs = cell
+		case !IsCellPositionUpToDate(dc, obs):
+			if err := c.api.UpdateCell(ctx, id, pointer.StringDeref(obs.Id, ""), GenerateCellUpdate(dc)); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateCell, dc.Name)
+			}
+		}
+		cellID := pointer.StringDeref(obs.Id, "")
+		// Only views that differ from the desired ones are rewritten. Views of
+		// new cells are not in the map.
+		if !IsViewUpToDate(dc, views[cellID]) {
+			if err := c.api.UpdateCellView(ctx, id, cellID, GenerateView(dc)); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateCellView, dc.Name)
+			}
+		}
+		keep[cellID] = true
+		applied = append(applied, v1alpha1.DashboardCellObservation{Name: dc.Name, ID: cellID})
+	}
+
+	if d.Cells != nil {
+		for _, cell := range *d.Cells {
+			cellID := pointer.StringDeref(cell.Id, "")
+			if keep[cellID] {
+				continue
+			}
+			if err := c.api.DeleteCell(ctx, id, cellID); resource.Ignore(clients.IsNotFound, err) != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteCell, cellID)
+			}
+		}
+	}
+	cr.Status.AtProvider.Cells = applied
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return errors.New(errNotDashboard)
+	}
+	err := c.api.DeleteDashboardWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteDashboard)
+}
+
+// getViews returns the views of the applied cells that exist in the given
+// dashboard keyed by cell ID.
+func (c *external) getViews(ctx context.Context, d *domain.Dashboard, applied []v1alpha1.DashboardCellObservation) (map[string]*domain.View, error) {
+	views := map[string]*domain.View{}
+	for _, a := range applied {
+		if FindCell(d.Cells, a.ID) == nil {
+			continue
+		}
+		v, err := c.api.GetCellView(ctx, pointer.StringDeref(d.Id, ""), a.ID)
+		if resource.Ignore(clients.IsNotFound, err) != nil {
+			return nil, errors.Wrapf(err, errGetCellView, a.Name)
+		}
+		views[a.ID] = v
+	}
+	return views, nil
+}
+
+// getCells returns the desired cells with the charts given either inline or
+// in the referenced ConfigMaps.
+func (c *external) getCells(ctx context.Context, cells []v1alpha1.DashboardCell) ([]DesiredCell, error) {
+	out := make([]DesiredCell, len(cells))
+	for i, cell := range cells {
+		var props string
+		switch {
+		case cell.Properties != nil:
+			props = *cell.Properties
+		case cell.PropertiesConfigMapRef != nil:
+			ref := cell.PropertiesConfigMapRef
+			cm := &corev1.ConfigMap{}
+			if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+				return nil, errors.Wrapf(err, errGetConfigMap, cell.Name)
+			}
+			s, ok := cm.Data[ref.Key]
+			if !ok {
+				return nil, errors.Errorf(errPropertiesKeyMissing, cell.Name)
+			}
+			props = s
+		default:
+			return nil, errors.Errorf(errNoProperties, cell.Name)
+		}
+		dc, err := NewDesiredCell(cell, props)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = dc
+	}
+	return out, nil
+}
+
+// dashboardName returns the name of the dashboard in InfluxDB.
+func dashboardName(cr *v1alpha1.Dashboard) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,957 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const (
+	props   = "kind: Markdown\nnote: hello"
+	changed = "kind: Markdown\nnote: changed"
+)
+
+func cell(name, p string) v1alpha1.DashboardCell {
+	return v1alpha1.DashboardCell{Name: name, W: 4, H: 4, Properties: pointer.String(p)}
+}
+
+// view returns the view of the given chart as it'd be returned by the API,
+// including a field that is not part of the chart.
+func view(t *testing.T, name, p string) *domain.View {
+	dc, err := NewDesiredCell(v1alpha1.DashboardCell{Name: name}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	props := map[string]interface{}{"id": "v1"}
+	for k, v := range dc.Properties.(map[string]interface{}) {
+		props[k] = v
+	}
+	return &domain.View{Name: name, Properties: props}
+}
+
+// observed returns the dashboard as it'd be returned by the API with the given
+// cell IDs.
+func observed(cr *v1alpha1.Dashboard, ids ...string) *domain.Dashboard {
+	cells := make(domain.Cells, len(ids))
+	for i, id := range ids {
+		cells[i] = domain.Cell{Id: pointer.String(id), W: pointer.Int32(4), H: pointer.Int32(4), X: pointer.Int32(0), Y: pointer.Int32(0)}
+	}
+	return &domain.Dashboard{
+		Id: pointer.String("id"),
+		CreateDashboardRequest: domain.CreateDashboardRequest{
+			Name:        dashboardName(cr),
+			Description: cr.Spec.ForProvider.Description,
+			OrgID:       pointer.StringDeref(cr.Spec.ForProvider.OrgID, ""),
+		},
+		Cells: &cells,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.DashboardsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotDashboard),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetDashboard),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoProperties": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{v1alpha1.DashboardCell{Name: "cpu"}},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errNoProperties, "cpu"),
+			},
+		},
+		"InvalidChart": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", "kind: Pie")},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrapf(errors.Errorf(errUnknownChartKind, "Pie"), errInvalidChart, "cpu"),
+			},
+		},
+		"GetCellViewFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props)},
+						},
+					},
+					Status: v1alpha1.DashboardStatus{
+						AtProvider: v1alpha1.DashboardObservation{
+							Cells: []v1alpha1.DashboardCellObservation{v1alpha1.DashboardCellObservation{Name: "cpu", ID: "c1"}},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}, "c1"), nil
+					},
+					GetCellViewFn: func(_ context.Context, _, _ string) (*domain.View, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrapf(errBoom, errGetCellView, "cpu"),
+			},
+		},
+		"DeletedConfigMapMissing": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "system",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							OrgID: pointer.String("org"),
+							Cells: []v1alpha1.DashboardCell{{
+								Name:                   "cpu",
+								W:                      4,
+								H:                      4,
+								PropertiesConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "cpu"},
+							}},
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("configmaps"), "cm")),
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									OrgID: pointer.String("org"),
+								},
+							},
+						}, "c1"), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props)},
+						},
+					},
+					Status: v1alpha1.DashboardStatus{
+						AtProvider: v1alpha1.DashboardObservation{
+							Cells: []v1alpha1.DashboardCellObservation{v1alpha1.DashboardCellObservation{Name: "cpu", ID: "c1"}},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}, "c1"), nil
+					},
+					GetCellViewFn: func(_ context.Context, _, _ string) (*domain.View, error) {
+						return view(t, "cpu", props), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CellMissing": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props)},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ViewChanged": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", changed)},
+						},
+					},
+					Status: v1alpha1.DashboardStatus{
+						AtProvider: v1alpha1.DashboardObservation{
+							Cells: []v1alpha1.DashboardCellObservation{v1alpha1.DashboardCellObservation{Name: "cpu", ID: "c1"}},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}, "c1"), nil
+					},
+					GetCellViewFn: func(_ context.Context, _, _ string) (*domain.View, error) {
+						return view(t, "cpu", props), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.DashboardsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotDashboard),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					CreateDashboardFn: func(_ context.Context, _ *domain.CreateDashboardRequest) (*domain.Dashboard, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateDashboard),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					CreateDashboardFn: func(_ context.Context, d *domain.CreateDashboardRequest) (*domain.Dashboard, error) {
+						if d.Name != "system" || d.OrgID != "org" {
+							t.Errorf("creation call has to use the name and the org of the dashboard")
+						}
+						return &domain.Dashboard{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.DashboardsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotDashboard),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "old",
+								Annotations: map[string]string{
+									meta.AnnotationKeyExternalName: "id",
+								},
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}), nil
+					},
+					UpdateDashboardFn: func(_ context.Context, _, _ string, _ *string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errUpdateDashboard),
+			},
+		},
+		"CreateCellFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props)},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}), nil
+					},
+					CreateCellFn: func(_ context.Context, _ string, _ domain.CreateCell) (*domain.Cell, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props)},
+						},
+					},
+				},
+				err: errors.Wrapf(errBoom, errCreateCell, "cpu"),
+			},
+		},
+		"SyncCells": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props), cell("mem", props), cell("disk", props)},
+						},
+					},
+					Status: v1alpha1.DashboardStatus{
+						AtProvider: v1alpha1.DashboardObservation{
+							Cells: []v1alpha1.DashboardCellObservation{v1alpha1.DashboardCellObservation{Name: "cpu", ID: "c1"}, v1alpha1.DashboardCellObservation{Name: "mem", ID: "c2"}, v1alpha1.DashboardCellObservation{Name: "net", ID: "c3"}},
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					GetDashboardByIDFn: func(_ context.Context, _ string) (*domain.Dashboard, error) {
+						return observed(&v1alpha1.Dashboard{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "system",
+							},
+							Spec: v1alpha1.DashboardSpec{
+								ForProvider: v1alpha1.DashboardParameters{
+									Description: pointer.String("system metrics"),
+									OrgID:       pointer.String("org"),
+								},
+							},
+						}, "c1", "c2", "c3"), nil
+					},
+					GetCellViewFn: func(_ context.Context, _, cellID string) (*domain.View, error) {
+						switch cellID {
+						case "c1":
+							return view(t, "cpu", props), nil
+						case "c2":
+							// The view was edited in the UI.
+							return view(t, "mem", changed), nil
+						}
+						return view(t, "net", props), nil
+					},
+					CreateCellFn: func(_ context.Context, _ string, c domain.CreateCell) (*domain.Cell, error) {
+						if pointer.StringDeref(c.Name, "") != "disk" {
+							t.Errorf("only the missing cell has to be created")
+						}
+						return &domain.Cell{Id: pointer.String("c4")}, nil
+					},
+					UpdateCellViewFn: func(_ context.Context, _, cellID string, v domain.View) error {
+						if cellID != "c2" && cellID != "c4" {
+							t.Errorf("only views of changed or new cells have to be rewritten, got %s", cellID)
+						}
+						if v.Properties.(map[string]interface{})["type"] != "markdown" {
+							t.Errorf("the chart has to be converted to view properties")
+						}
+						return nil
+					},
+					DeleteCellFn: func(_ context.Context, _, cellID string) error {
+						if cellID != "c3" {
+							t.Errorf("only the removed cell has to be deleted, got %s", cellID)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+							Cells:       []v1alpha1.DashboardCell{cell("cpu", props), cell("mem", props), cell("disk", props)},
+						},
+					},
+					Status: v1alpha1.DashboardStatus{
+						AtProvider: v1alpha1.DashboardObservation{
+							Cells: []v1alpha1.DashboardCellObservation{v1alpha1.DashboardCellObservation{Name: "cpu", ID: "c1"}, v1alpha1.DashboardCellObservation{Name: "mem", ID: "c2"}, v1alpha1.DashboardCellObservation{Name: "disk", ID: "c4"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.DashboardsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotDashboard),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					DeleteDashboardWithIDFn: func(_ context.Context, id string) error {
+						if id != "id" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					DeleteDashboardWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Dashboard{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.DashboardSpec{
+						ForProvider: v1alpha1.DashboardParameters{
+							Description: pointer.String("system metrics"),
+							OrgID:       pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockDashboardsAPI{
+					DeleteDashboardWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteDashboard),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// DesiredCell is a cell of the desired state with the view properties
+// generated from its chart.
+type DesiredCell struct {
+	v1alpha1.DashboardCell
+
+	// Properties are the view properties as they are sent to the API.
+	Properties interface{}
+}
+
+// NewDesiredCell returns a DesiredCell with the view properties generated
+// from the given chart.
+func NewDesiredCell(cell v1alpha1.DashboardCell, spec string) (DesiredCell, error) {
+	c := chart{}
+	if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(spec), 4096).Decode(&c); err != nil {
+		return DesiredCell{}, errors.Wrapf(err, errInvalidChart, cell.Name)
+	}
+	vp, err := c.viewProperties()
+	if err != nil {
+		return DesiredCell{}, errors.Wrapf(err, errInvalidChart, cell.Name)
+	}
+	// The properties are kept in their JSON form so that they can be compared
+	// with the ones returned by the API.
+	b, err := json.Marshal(vp)
+	if err != nil {
+		return DesiredCell{}, errors.Wrapf(err, errInvalidChart, cell.Name)
+	}
+	var props interface{}
+	if err := json.Unmarshal(b, &props); err != nil {
+		return DesiredCell{}, errors.Wrapf(err, errInvalidChart, cell.Name)
+	}
+	return DesiredCell{DashboardCell: cell, Properties: props}, nil
+}
+
+// GenerateDashboardObservation converts a Dashboard response to an
+// observation. The applied cells are not part of the response.
+func GenerateDashboardObservation(d *domain.Dashboard) v1alpha1.DashboardObservation {
+	o := v1alpha1.DashboardObservation{
+		ID: pointer.StringDeref(d.Id, ""),
+	}
+	if d.Meta != nil {
+		if d.Meta.CreatedAt != nil {
+			o.CreatedAt = metav1.NewTime(*d.Meta.CreatedAt)
+		}
+		if d.Meta.UpdatedAt != nil {
+			o.UpdatedAt = metav1.NewTime(*d.Meta.UpdatedAt)
+		}
+	}
+	return o
+}
+
+// GenerateCreateDashboardRequest returns a CreateDashboardRequest that the
+// InfluxDB API accepts for creation.
+func GenerateCreateDashboardRequest(name string, params v1alpha1.DashboardParameters) *domain.CreateDashboardRequest {
+	return &domain.CreateDashboardRequest{
+		Name:        name,
+		Description: params.Description,
+		OrgID:       pointer.StringDeref(params.OrgID, ""),
+	}
+}
+
+// GenerateCreateCell returns a CreateCell that creates the given cell.
+func GenerateCreateCell(c DesiredCell) domain.CreateCell {
+	return domain.CreateCell{
+		Name: pointer.String(c.Name),
+		X:    pointer.Int32(c.X),
+		Y:    pointer.Int32(c.Y),
+		W:    pointer.Int32(c.W),
+		H:    pointer.Int32(c.H),
+	}
+}
+
+// GenerateCellUpdate returns a CellUpdate that moves and resizes a cell to
+// match the given one.
+func GenerateCellUpdate(c DesiredCell) domain.CellUpdate {
+	return domain.CellUpdate{
+		X: pointer.Int32(c.X),
+		Y: pointer.Int32(c.Y),
+		W: pointer.Int32(c.W),
+		H: pointer.Int32(c.H),
+	}
+}
+
+// GenerateView returns the view of the given cell.
+func GenerateView(c DesiredCell) domain.View {
+	return domain.View{
+		Name:       c.Name,
+		Properties: c.Properties,
+	}
+}
+
+// FindCell returns the cell with the given ID from the list or nil if it is
+// not found.
+func FindCell(cells *domain.Cells, id string) *domain.Cell {
+	if cells == nil || id == "" {
+		return nil
+	}
+	for i := range *cells {
+		if pointer.StringDeref((*cells)[i].Id, "") == id {
+			return &(*cells)[i]
+		}
+	}
+	return nil
+}
+
+// FindAppliedCell returns the applied cell with the given name from the list
+// or nil if it is not found.
+func FindAppliedCell(cells []v1alpha1.DashboardCellObservation, name string) *v1alpha1.DashboardCellObservation {
+	for i := range cells {
+		if cells[i].Name == name {
+			return &cells[i]
+		}
+	}
+	return nil
+}
+
+// IsCellPositionUpToDate returns whether the position and size of the
+// observed cell match the desired ones.
+func IsCellPositionUpToDate(c DesiredCell, obs *domain.Cell) bool {
+	return c.X == pointer.Int32Deref(obs.X, 0) &&
+		c.Y == pointer.Int32Deref(obs.Y, 0) &&
+		c.W == pointer.Int32Deref(obs.W, 0) &&
+		c.H == pointer.Int32Deref(obs.H, 0)
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.DashboardParameters, obs *domain.Dashboard) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	return li.IsChanged()
+}
+
+// IsViewUpToDate returns whether the observed view matches the desired cell.
+// Fields that are not part of the desired properties, such as the ones the
+// API adds with their defaults, are ignored.
+func IsViewUpToDate(c DesiredCell, obs *domain.View) bool {
+	return obs != nil && obs.Name == c.Name && isSubset(c.Properties, obs.Properties)
+}
+
+// isSubset returns whether the given JSON value is contained in the observed
+// one. Missing observed fields match desired zero values.
+func isSubset(desired, observed interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		o, ok := observed.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && observed == nil
+		}
+		for k, v := range d {
+			ov, ok := o[k]
+			if !ok {
+				if !isZero(v) {
+					return false
+				}
+				continue
+			}
+			if !isSubset(v, ov) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		o, ok := observed.([]interface{})
+		if !ok {
+			return len(d) == 0 && observed == nil
+		}
+		if len(d) != len(o) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return isZero(observed)
+	default:
+		return reflect.DeepEqual(desired, observed)
+	}
+}
+
+// isZero returns whether the given JSON value is empty.
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	default:
+		return reflect.ValueOf(v).IsZero()
+	}
+}
+
+// IsUpToDate returns whether an update call is necessary. Cells are matched
+// with the cells that were applied last and their views are compared with the
+// observed ones, keyed by cell ID.
+func IsUpToDate(name string, params v1alpha1.DashboardParameters, desired []DesiredCell, applied []v1alpha1.DashboardCellObservation, obs *domain.Dashboard, views map[string]*domain.View) bool {
+	if name != obs.Name || pointer.StringDeref(params.Description, "") != pointer.StringDeref(obs.Description, "") {
+		return false
+	}
+	observed := 0
+	if obs.Cells != nil {
+		observed = len(*obs.Cells)
+	}
+	if len(desired) != observed {
+		return false
+	}
+	for _, c := range desired {
+		a := FindAppliedCell(applied, c.Name)
+		if a == nil {
+			return false
+		}
+		o := FindCell(obs.Cells, a.ID)
+		if o == nil || !IsCellPositionUpToDate(c, o) || !IsViewUpToDate(c, views[a.ID]) {
+			return false
+		}
+	}
+	return true
+}
@@ -44,6 +44,8 @@ const (
 	errCreateDatabaseRetentionPolicyMapping = "cannot create dbrp"
 	errUpdateDatabaseRetentionPolicyMapping = "cannot update dbrp"
 	errDeleteDatabaseRetentionPolicyMapping = "cannot delete dbrp"
+
+	errFindDefaultOrg = "cannot find default organization"
 )
 
 // Setup adds a controller that reconciles DatabaseRetentionPolicyMapping managed resources.
@@ -80,11 +82,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cl, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create a new client")
 	}
-	return &external{api: cl}, nil
+	return &external{api: cs.WithResponses, orgs: cs.Client.OrganizationsAPI(), defaultOrg: cs.DefaultOrganization}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -92,7 +94,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
-	api clients.DBRPsAPI
+	api  clients.DBRPsAPI
+	orgs clients.OrganizationsAPI
+
+	// defaultOrg is the name or ID of the organization that the mapping
+	// belongs to if it does not specify one.
+	defaultOrg string
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -104,9 +111,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
+	org, orgID, err := c.organization(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	dbrps, err := c.api.GetDBRPsWithResponse(ctx, &domain.GetDBRPsParams{
-		Org: &cr.Spec.ForProvider.Org,
-		Id:  pointer.String(meta.GetExternalName(cr)),
+		Org:   org,
+		OrgID: orgID,
+		Id:    pointer.String(meta.GetExternalName(cr)),
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetDatabaseRetentionPolicyMapping)
@@ -118,6 +130,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if dbrp.Links != nil {
 		cr.Status.AtProvider.Links.Self = string(dbrp.Links.Self)
 	}
+	cr.Status.AtProvider.OrgID = dbrp.OrgID
 	cr.SetConditions(v1.Available())
 	li := resource.NewLateInitializer()
 	cr.Spec.ForProvider.Default = li.LateInitializeBoolPtr(cr.Spec.ForProvider.Default, &dbrp.Default)
@@ -135,11 +148,16 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotDatabaseRetentionPolicyMapping)
 	}
 
+	org, orgID, err := c.organization(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	resp, err := c.api.PostDBRPWithResponse(ctx, &domain.PostDBRPParams{}, domain.PostDBRPJSONRequestBody{
 		BucketID:        cr.Spec.ForProvider.BucketID,
 		Database:        cr.Spec.ForProvider.Database,
 		Default:         cr.Spec.ForProvider.Default,
-		Org:             &cr.Spec.ForProvider.Org,
+		Org:             org,
+		OrgID:           orgID,
 		RetentionPolicy: cr.Spec.ForProvider.RetentionPolicy,
 	})
 	if err != nil {
@@ -169,8 +187,31 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotDatabaseRetentionPolicyMapping)
 	}
-	_, err := c.api.DeleteDBRPIDWithResponse(ctx, meta.GetExternalName(cr), &domain.DeleteDBRPIDParams{
-		Org: pointer.String(cr.Spec.ForProvider.Org),
+	org, orgID, err := c.organization(ctx, cr)
+	if err != nil {
+		return err
+	}
+	_, err = c.api.DeleteDBRPIDWithResponse(ctx, meta.GetExternalName(cr), &domain.DeleteDBRPIDParams{
+		Org:   org,
+		OrgID: orgID,
 	})
 	return errors.Wrap(err, errDeleteDatabaseRetentionPolicyMapping)
 }
+
+// organization returns the name or the ID of the organization of the mapping
+// to send to the API. Only one of them is set; the ID is used if the mapping
+// falls back to the default organization of its ProviderConfig. The default
+// organization is looked up only until its ID is stored in the status.
+func (c *external) organization(ctx context.Context, cr *v1alpha1.DatabaseRetentionPolicyMapping) (org, orgID *string, err error) {
+	if cr.Spec.ForProvider.Org != "" || c.defaultOrg == "" {
+		return pointer.String(cr.Spec.ForProvider.Org), nil, nil
+	}
+	if id := cr.Status.AtProvider.OrgID; id != "" {
+		return nil, pointer.String(id), nil
+	}
+	o, err := clients.FindOrganization(ctx, c.orgs, c.defaultOrg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errFindDefaultOrg)
+	}
+	return nil, o.Id, nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
 
 	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
@@ -41,8 +42,10 @@ var (
 
 func TestObserve(t *testing.T) {
 	type args struct {
-		mg  resource.Managed
-		api clients.DBRPsAPI
+		mg         resource.Managed
+		api        clients.DBRPsAPI
+		orgs       clients.OrganizationsAPI
+		defaultOrg string
 	}
 	type want struct {
 		err error
@@ -143,11 +146,110 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"DefaultOrganization": {
+			args: args{
+				mg: &v1alpha1.DatabaseRetentionPolicyMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "test",
+						},
+					},
+				},
+				api: &clients.MockDBRPsAPI{
+					GetDBRPsWithResponseFn: func(_ context.Context, params *domain.GetDBRPsParams) (*domain.GetDBRPsResponse, error) {
+						if params.Org != nil || pointer.StringDeref(params.OrgID, "") != "0123456789abcdef" {
+							t.Errorf("get call has to use the ID of the default organization")
+						}
+						return &domain.GetDBRPsResponse{JSON200: &domain.DBRPs{Content: &[]domain.DBRP{
+							{
+								OrgID:   "0123456789abcdef",
+								Default: true,
+							},
+						}}}, nil
+					},
+				},
+				orgs: &clients.MockOrganizationsAPI{
+					FindOrganizationByIDFn: func(_ context.Context, id string) (*domain.Organization, error) {
+						return &domain.Organization{Id: pointer.String(id)}, nil
+					},
+				},
+				defaultOrg: "0123456789abcdef",
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ObservedDefaultOrganization": {
+			args: args{
+				mg: &v1alpha1.DatabaseRetentionPolicyMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "test",
+						},
+					},
+					Status: v1alpha1.DatabaseRetentionPolicyMappingStatus{
+						AtProvider: v1alpha1.DatabaseRetentionPolicyMappingObservation{OrgID: "0123456789abcdef"},
+					},
+				},
+				api: &clients.MockDBRPsAPI{
+					GetDBRPsWithResponseFn: func(_ context.Context, params *domain.GetDBRPsParams) (*domain.GetDBRPsResponse, error) {
+						if params.Org != nil || pointer.StringDeref(params.OrgID, "") != "0123456789abcdef" {
+							t.Errorf("get call has to use the observed ID of the default organization")
+						}
+						return &domain.GetDBRPsResponse{JSON200: &domain.DBRPs{Content: &[]domain.DBRP{
+							{
+								OrgID:   "0123456789abcdef",
+								Default: true,
+							},
+						}}}, nil
+					},
+				},
+				orgs: &clients.MockOrganizationsAPI{
+					FindOrganizationByNameFn: func(_ context.Context, _ string) (*domain.Organization, error) {
+						t.Errorf("the default organization must not be looked up once its ID is observed")
+						return nil, errBoom
+					},
+				},
+				defaultOrg: "myorg",
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"FindDefaultOrganizationFailed": {
+			args: args{
+				mg: &v1alpha1.DatabaseRetentionPolicyMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "test",
+						},
+					},
+				},
+				orgs: &clients.MockOrganizationsAPI{
+					FindOrganizationByNameFn: func(_ context.Context, _ string) (*domain.Organization, error) {
+						return nil, errBoom
+					},
+				},
+				defaultOrg: "myorg",
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errFindDefaultOrg),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			e := &external{api: tc.args.api, orgs: tc.args.orgs, defaultOrg: tc.args.defaultOrg}
+			obs, err := e.Observe(context.TODO(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
@@ -160,8 +262,10 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
-		mg  resource.Managed
-		api clients.DBRPsAPI
+		mg         resource.Managed
+		api        clients.DBRPsAPI
+		orgs       clients.OrganizationsAPI
+		defaultOrg string
 	}
 	type want struct {
 		err error
@@ -193,11 +297,31 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateDatabaseRetentionPolicyMapping),
 			},
 		},
+		"DefaultOrganization": {
+			args: args{
+				mg: &v1alpha1.DatabaseRetentionPolicyMapping{},
+				api: &clients.MockDBRPsAPI{
+					PostDBRPWithResponseFn: func(_ context.Context, _ *domain.PostDBRPParams, body domain.PostDBRPJSONRequestBody) (*domain.PostDBRPResponse, error) {
+						if body.Org != nil || pointer.StringDeref(body.OrgID, "") != "orgid" {
+							t.Errorf("creation call has to use the ID of the default organization")
+						}
+						return &domain.PostDBRPResponse{JSON201: &domain.DBRP{Id: "id"}}, nil
+					},
+				},
+				orgs: &clients.MockOrganizationsAPI{
+					FindOrganizationByNameFn: func(_ context.Context, name string) (*domain.Organization, error) {
+						return &domain.Organization{Id: pointer.String("orgid"), Name: name}, nil
+					},
+				},
+				defaultOrg: "myorg",
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			e := &external{api: tc.args.api, orgs: tc.args.orgs, defaultOrg: tc.args.defaultOrg}
+			cre, err := e.Create(context.TODO(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
@@ -260,8 +384,9 @@ func TestUpdate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	type args struct {
-		mg  resource.Managed
-		api clients.DBRPsAPI
+		mg         resource.Managed
+		api        clients.DBRPsAPI
+		defaultOrg string
 	}
 	type want struct {
 		err error
@@ -298,6 +423,29 @@ func TestDelete(t *testing.T) {
 				},
 			},
 		},
+		"ObservedDefaultOrganization": {
+			args: args{
+				mg: &v1alpha1.DatabaseRetentionPolicyMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Status: v1alpha1.DatabaseRetentionPolicyMappingStatus{
+						AtProvider: v1alpha1.DatabaseRetentionPolicyMappingObservation{OrgID: "0123456789abcdef"},
+					},
+				},
+				api: &clients.MockDBRPsAPI{
+					DeleteDBRPIDWithResponseFn: func(_ context.Context, _ string, params *domain.DeleteDBRPIDParams) (*domain.DeleteDBRPIDResponse, error) {
+						if params.Org != nil || pointer.StringDeref(params.OrgID, "") != "0123456789abcdef" {
+							t.Errorf("deletion call has to use the observed ID of the default organization")
+						}
+						return nil, nil
+					},
+				},
+				defaultOrg: "myorg",
+			},
+		},
 		"DeleteFailed": {
 			args: args{
 				mg: &v1alpha1.DatabaseRetentionPolicyMapping{},
@@ -315,7 +463,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			err := (&external{api: tc.args.api, defaultOrg: tc.args.defaultOrg}).Delete(context.TODO(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Delete(...): -want, +got:\n%s", diff)
 			}
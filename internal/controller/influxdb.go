@@ -21,22 +21,62 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/authorization"
 	"github.com/crossplane-contrib/provider-influxdb/internal/controller/bucket"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/check"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/dashboard"
 	"github.com/crossplane-contrib/provider-influxdb/internal/controller/dbrp"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/label"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/legacyauthorization"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/measurementschema"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/notificationendpoint"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/notificationrule"
 	"github.com/crossplane-contrib/provider-influxdb/internal/controller/organization"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/organizationmember"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/organizationsecret"
 	"github.com/crossplane-contrib/provider-influxdb/internal/controller/providerconfig"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/remoteconnection"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/replication"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/scrapertarget"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/setup"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/stack"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/task"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/taskrun"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/telegraf"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/user"
+	"github.com/crossplane-contrib/provider-influxdb/internal/controller/variable"
 )
 
 // Setup creates all Template controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.RateLimiter) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
+	for _, setupFn := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
 		providerconfig.Setup,
 		organization.Setup,
 		bucket.Setup,
 		dbrp.Setup,
+		authorization.Setup,
+		user.Setup,
+		organizationmember.Setup,
+		label.Setup,
+		task.Setup,
+		taskrun.Setup,
+		check.Setup,
+		notificationendpoint.Setup,
+		notificationrule.Setup,
+		dashboard.Setup,
+		variable.Setup,
+		telegraf.Setup,
+		scrapertarget.Setup,
+		organizationsecret.Setup,
+		stack.Setup,
+		remoteconnection.Setup,
+		replication.Setup,
+		measurementschema.Setup,
+		setup.Setup,
+		legacyauthorization.Setup,
 	} {
-		if err := setup(mgr, l, wl); err != nil {
+		if err := setupFn(mgr, l, wl); err != nil {
 			return err
 		}
 	}
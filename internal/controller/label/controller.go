@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotLabel    = "managed resource is not a Label custom resource"
+	errFindLabel   = "cannot find label"
+	errCreateLabel = "cannot create label"
+	errUpdateLabel = "cannot update label"
+	errDeleteLabel = "cannot delete label"
+)
+
+// Setup adds a controller that reconciles Label managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LabelGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LabelGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Label{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: cl.LabelsAPI()}, nil
+}
+
+type external struct {
+	api clients.LabelsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLabel)
+	}
+
+	label, err := c.api.FindLabelByName(ctx, pointer.StringDeref(cr.Spec.ForProvider.OrgID, ""), meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(IsNotFoundFn(meta.GetExternalName(cr)), err), errFindLabel)
+	}
+
+	cr.Status.AtProvider = GenerateLabelObservation(label)
+	cr.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: IsUpToDate(cr.Spec.ForProvider, label),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLabel)
+	}
+
+	_, err := c.api.CreateLabel(ctx, GenerateLabelCreateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider))
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLabel)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLabel)
+	}
+
+	_, err := c.api.UpdateLabel(ctx, GenerateLabel(cr.Status.AtProvider.ID, meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider.Properties))
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLabel)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Label)
+	if !ok {
+		return errors.New(errNotLabel)
+	}
+	err := c.api.DeleteLabelWithID(ctx, cr.Status.AtProvider.ID)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteLabel)
+}
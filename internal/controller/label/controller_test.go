@@ -0,0 +1,379 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.LabelsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLabel": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLabel),
+			},
+		},
+		"FindFailed": {
+			args: args{
+				mg: &v1alpha1.Label{},
+				api: &clients.MockLabelsAPI{
+					FindLabelByNameFn: func(_ context.Context, _, _ string) (*domain.Label, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errFindLabel),
+			},
+		},
+		"NotFoundCreationNeeded": {
+			args: args{
+				mg: &v1alpha1.Label{},
+				api: &clients.MockLabelsAPI{
+					FindLabelByNameFn: func(_ context.Context, _, name string) (*domain.Label, error) {
+						return nil, fmt.Errorf("label '%s' not found", name)
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Label{
+					Spec: v1alpha1.LabelSpec{
+						ForProvider: v1alpha1.LabelParameters{
+							Properties: v1alpha1.LabelProperties{
+								Color: pointer.String("#326BBA"),
+							},
+						},
+					},
+				},
+				api: &clients.MockLabelsAPI{
+					FindLabelByNameFn: func(_ context.Context, _, _ string) (*domain.Label, error) {
+						return &domain.Label{
+							Properties: &domain.Label_Properties{
+								AdditionalProperties: map[string]string{"color": "#326BBA"},
+							},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpdateNeeded": {
+			args: args{
+				mg: &v1alpha1.Label{
+					Spec: v1alpha1.LabelSpec{
+						ForProvider: v1alpha1.LabelParameters{
+							Properties: v1alpha1.LabelProperties{
+								Description: pointer.String("new"),
+							},
+						},
+					},
+				},
+				api: &clients.MockLabelsAPI{
+					FindLabelByNameFn: func(_ context.Context, _, _ string) (*domain.Label, error) {
+						return &domain.Label{
+							Properties: &domain.Label_Properties{
+								AdditionalProperties: map[string]string{"description": "old"},
+							},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.LabelsAPI
+	}
+	type want struct {
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLabel": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLabel),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Label{},
+				api: &clients.MockLabelsAPI{
+					CreateLabelFn: func(_ context.Context, _ *domain.LabelCreateRequest) (*domain.Label, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateLabel),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Label{
+					Spec: v1alpha1.LabelSpec{
+						ForProvider: v1alpha1.LabelParameters{
+							OrgID: pointer.String("org"),
+							Properties: v1alpha1.LabelProperties{
+								Color: pointer.String("#326BBA"),
+							},
+						},
+					},
+				},
+				api: &clients.MockLabelsAPI{
+					CreateLabelFn: func(_ context.Context, l *domain.LabelCreateRequest) (*domain.Label, error) {
+						if l.OrgID != "org" {
+							t.Errorf("creation call has to include the org id")
+						}
+						if l.Properties == nil || l.Properties.AdditionalProperties["color"] != "#326BBA" {
+							t.Errorf("creation call has to include the properties")
+						}
+						return &domain.Label{}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.LabelsAPI
+	}
+	type want struct {
+		err error
+		upd managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLabel": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLabel),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Label{},
+				api: &clients.MockLabelsAPI{
+					UpdateLabelFn: func(_ context.Context, _ *domain.Label) (*domain.Label, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateLabel),
+			},
+		},
+		"RemovedKeysAreEmptied": {
+			args: args{
+				mg: &v1alpha1.Label{
+					Spec: v1alpha1.LabelSpec{
+						ForProvider: v1alpha1.LabelParameters{
+							Properties: v1alpha1.LabelProperties{
+								Color: pointer.String("#326BBA"),
+							},
+						},
+					},
+					Status: v1alpha1.LabelStatus{
+						AtProvider: v1alpha1.LabelObservation{
+							ID: "id",
+							Properties: v1alpha1.LabelProperties{
+								Description: pointer.String("old"),
+							},
+						},
+					},
+				},
+				api: &clients.MockLabelsAPI{
+					UpdateLabelFn: func(_ context.Context, l *domain.Label) (*domain.Label, error) {
+						if pointer.StringDeref(l.Id, "") != "id" {
+							t.Errorf("update call has to use the id of the label")
+						}
+						want := map[string]string{"color": "#326BBA", "description": ""}
+						if diff := cmp.Diff(want, l.Properties.AdditionalProperties); diff != "" {
+							t.Errorf("UpdateLabel(...): -want, +got:\n%s", diff)
+						}
+						return &domain.Label{}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upd, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.LabelsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLabel": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLabel),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Label{
+					Status: v1alpha1.LabelStatus{
+						AtProvider: v1alpha1.LabelObservation{ID: "testid"},
+					},
+				},
+				api: &clients.MockLabelsAPI{
+					DeleteLabelWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Label{},
+				api: &clients.MockLabelsAPI{
+					DeleteLabelWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteLabel),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// Well-known property keys of a label that are used by the InfluxDB UI.
+const (
+	keyColor       = "color"
+	keyDescription = "description"
+)
+
+// GenerateLabelObservation converts a Label response to an observation.
+func GenerateLabelObservation(l *domain.Label) v1alpha1.LabelObservation {
+	o := v1alpha1.LabelObservation{
+		ID:    pointer.StringDeref(l.Id, ""),
+		Name:  pointer.StringDeref(l.Name, ""),
+		OrgID: pointer.StringDeref(l.OrgID, ""),
+	}
+	if l.Properties != nil {
+		o.Properties = GenerateLabelProperties(l.Properties.AdditionalProperties)
+	}
+	return o
+}
+
+// GenerateLabelProperties converts the Key/Value pairs of a label to
+// LabelProperties.
+func GenerateLabelProperties(props map[string]string) v1alpha1.LabelProperties {
+	out := v1alpha1.LabelProperties{}
+	for k, v := range props {
+		switch k {
+		case keyColor:
+			out.Color = pointer.String(v)
+		case keyDescription:
+			out.Description = pointer.String(v)
+		default:
+			if out.AdditionalProperties == nil {
+				out.AdditionalProperties = map[string]string{}
+			}
+			out.AdditionalProperties[k] = v
+		}
+	}
+	return out
+}
+
+// GeneratePropertiesMap converts LabelProperties to the Key/Value pairs that
+// the InfluxDB API accepts.
+func GeneratePropertiesMap(p v1alpha1.LabelProperties) map[string]string {
+	out := make(map[string]string, len(p.AdditionalProperties)+2)
+	for k, v := range p.AdditionalProperties {
+		out[k] = v
+	}
+	if p.Color != nil {
+		out[keyColor] = *p.Color
+	}
+	if p.Description != nil {
+		out[keyDescription] = *p.Description
+	}
+	return out
+}
+
+// GenerateLabelCreateRequest returns a LabelCreateRequest model that the
+// InfluxDB API accepts for creation.
+func GenerateLabelCreateRequest(name string, params v1alpha1.LabelParameters) *domain.LabelCreateRequest {
+	return &domain.LabelCreateRequest{
+		Name:  name,
+		OrgID: pointer.StringDeref(params.OrgID, ""),
+		Properties: &domain.LabelCreateRequest_Properties{
+			AdditionalProperties: GeneratePropertiesMap(params.Properties),
+		},
+	}
+}
+
+// GenerateLabel returns a Label model that the InfluxDB API accepts for update.
+// The keys that exist in the observed properties but not in the desired ones
+// are sent with an empty value so that they get removed.
+func GenerateLabel(id, name string, params v1alpha1.LabelParameters, observed v1alpha1.LabelProperties) *domain.Label {
+	props := GeneratePropertiesMap(params.Properties)
+	for k := range GeneratePropertiesMap(observed) {
+		if _, ok := props[k]; !ok {
+			props[k] = ""
+		}
+	}
+	return &domain.Label{
+		Id:         pointer.String(id),
+		Name:       pointer.String(name),
+		Properties: &domain.Label_Properties{AdditionalProperties: props},
+	}
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(params v1alpha1.LabelParameters, obs *domain.Label) bool {
+	desired := GeneratePropertiesMap(params.Properties)
+	observed := map[string]string{}
+	if obs.Properties != nil {
+		observed = obs.Properties.AdditionalProperties
+	}
+	if len(desired) != len(observed) {
+		return false
+	}
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNotFoundFn returns an ErrorIs function that can tell whether the error is
+// of kind NotFound.
+func IsNotFoundFn(name string) resource.ErrorIs {
+	return func(err error) bool {
+		return strings.Contains(err.Error(), fmt.Sprintf(`label '%s' not found`, name))
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package legacyauthorization
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotLegacyAuthorization    = "managed resource is not a LegacyAuthorization custom resource"
+	errGetLegacyAuthorization    = "cannot get legacy authorization"
+	errCreateLegacyAuthorization = "cannot create legacy authorization"
+	errUpdateLegacyAuthorization = "cannot update legacy authorization"
+	errDeleteLegacyAuthorization = "cannot delete legacy authorization"
+	errSetPassword               = "cannot set password of legacy authorization"
+	errGetPasswordSecret         = "cannot get password secret"
+	errPasswordKeyMissing        = "password key is not found in the referenced secret"
+)
+
+// Setup adds a controller that reconciles LegacyAuthorization managed
+// resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LegacyAuthorizationGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LegacyAuthorizationGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.LegacyAuthorization{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewLegacyAuthorizationsAPI(cl.HTTPService())}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.LegacyAuthorizationsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LegacyAuthorization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLegacyAuthorization)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	auth, err := c.api.GetLegacyAuthorizationByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetLegacyAuthorization)
+	}
+	// The password Secret is often deleted together with the resource, so it
+	// is not read when only the deletion is left.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	obs := GenerateLegacyAuthorizationObservation(auth)
+	obs.PasswordSecretVersion = cr.Status.AtProvider.PasswordSecretVersion
+	cr.Status.AtProvider = obs
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.AuthorizationUpdateRequestStatusActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.AuthorizationUpdateRequestStatusInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+
+	s, err := c.getPasswordSecret(ctx, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	pwUpToDate := clients.SecretVersion(s) == cr.Status.AtProvider.PasswordSecretVersion
+
+	li := LateInitialize(&cr.Spec.ForProvider, auth)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        pwUpToDate && IsUpToDate(cr.Spec.ForProvider, auth),
+		ConnectionDetails:       GetConnectionDetails(pointer.StringDeref(auth.Token, ""), s.Data[cr.Spec.ForProvider.PasswordSecretRef.Key], cr.Spec.ForProvider.Database),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LegacyAuthorization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLegacyAuthorization)
+	}
+
+	auth, err := c.api.CreateLegacyAuthorization(ctx, GenerateLegacyAuthorization(username(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLegacyAuthorization)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(auth.Id, ""))
+	return managed.ExternalCreation{}, c.setPassword(ctx, cr)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LegacyAuthorization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLegacyAuthorization)
+	}
+
+	// Only the description and the status of a legacy authorization can be
+	// changed after creation.
+	if _, err := c.api.UpdateLegacyAuthorization(ctx, meta.GetExternalName(cr), GenerateLegacyAuthorizationUpdate(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLegacyAuthorization)
+	}
+	return managed.ExternalUpdate{}, c.setPassword(ctx, cr)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LegacyAuthorization)
+	if !ok {
+		return errors.New(errNotLegacyAuthorization)
+	}
+	err := c.api.DeleteLegacyAuthorizationWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteLegacyAuthorization)
+}
+
+func (c *external) getPasswordSecret(ctx context.Context, ref v1.SecretKeySelector) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetPasswordSecret)
+	}
+	if _, ok := s.Data[ref.Key]; !ok {
+		return nil, errors.New(errPasswordKeyMissing)
+	}
+	return s, nil
+}
+
+// setPassword applies the password in the referenced Secret and records the
+// version of the Secret that was applied.
+func (c *external) setPassword(ctx context.Context, cr *v1alpha1.LegacyAuthorization) error {
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	s, err := c.getPasswordSecret(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if err := c.api.SetLegacyAuthorizationPassword(ctx, meta.GetExternalName(cr), string(s.Data[ref.Key])); err != nil {
+		return errors.Wrap(err, errSetPassword)
+	}
+	cr.Status.AtProvider.PasswordSecretVersion = clients.SecretVersion(s)
+	return nil
+}
+
+// username returns the username that v1 clients log in with.
+func username(cr *v1alpha1.LegacyAuthorization) string {
+	if cr.Spec.ForProvider.Username != nil {
+		return *cr.Spec.ForProvider.Username
+	}
+	return cr.GetName()
+}
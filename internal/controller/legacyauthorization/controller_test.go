@@ -0,0 +1,869 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package legacyauthorization
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func mockSecretGet(version string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.SetResourceVersion(version)
+		s.Data = map[string][]byte{"password": []byte("s3cr3t")}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	active := domain.AuthorizationUpdateRequestStatusActive
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.LegacyAuthorizationsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	found := func(_ context.Context, _ string) (*domain.Authorization, error) {
+		return &domain.Authorization{
+			Id:    pointer.String("id"),
+			Token: pointer.String("grafana"),
+			AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+				Status: &active,
+			},
+		}, nil
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLegacyAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLegacyAuthorization),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: func(_ context.Context, _ string) (*domain.Authorization, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetLegacyAuthorization),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: func(_ context.Context, _ string) (*domain.Authorization, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: found,
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPasswordSecret),
+			},
+		},
+		"DeletedSecretMissing": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "grafana",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("secrets"), "grafana")),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: found,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							Status:         pointer.String("active"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: found,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyUsername: []byte("grafana"),
+						keyPassword: []byte("s3cr3t"),
+						keyDatabase: []byte("telegraf"),
+					},
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							Status:         pointer.String("active"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("2"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: found,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyUsername: []byte("grafana"),
+						keyPassword: []byte("s3cr3t"),
+						keyDatabase: []byte("telegraf"),
+					},
+				},
+			},
+		},
+		"StatusChanged": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							Status:         pointer.String("inactive"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					GetLegacyAuthorizationByIDFn: found,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyUsername: []byte("grafana"),
+						keyPassword: []byte("s3cr3t"),
+						keyDatabase: []byte("telegraf"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.LegacyAuthorizationsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLegacyAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotLegacyAuthorization),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					CreateLegacyAuthorizationFn: func(_ context.Context, _ *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateLegacyAuthorization),
+			},
+		},
+		"SetPasswordFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					CreateLegacyAuthorizationFn: func(_ context.Context, _ *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error) {
+						return &domain.Authorization{Id: pointer.String("id")}, nil
+					},
+					SetLegacyAuthorizationPasswordFn: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errSetPassword),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					CreateLegacyAuthorizationFn: func(_ context.Context, req *domain.LegacyAuthorizationPostRequest) (*domain.Authorization, error) {
+						if pointer.StringDeref(req.Token, "") != "grafana" {
+							t.Errorf("creation call has to default the username to the name of the managed resource")
+						}
+						if req.Permissions == nil || len(*req.Permissions) != 3 {
+							t.Errorf("creation call has to include a permission for each read and write bucket")
+						}
+						return &domain.Authorization{Id: pointer.String("id")}, nil
+					},
+					SetLegacyAuthorizationPasswordFn: func(_ context.Context, id, pw string) error {
+						if id != "id" || pw != "s3cr3t" {
+							t.Errorf("password has to be set on the created legacy authorization")
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.LegacyAuthorizationsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLegacyAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotLegacyAuthorization),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					UpdateLegacyAuthorizationFn: func(_ context.Context, _ string, _ *domain.AuthorizationUpdateRequest) (*domain.Authorization, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errUpdateLegacyAuthorization),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							Status:         pointer.String("inactive"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("2"),
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					UpdateLegacyAuthorizationFn: func(_ context.Context, id string, req *domain.AuthorizationUpdateRequest) (*domain.Authorization, error) {
+						if id != "id" || req.Status == nil || *req.Status != domain.AuthorizationUpdateRequestStatusInactive {
+							t.Errorf("update call has to use the desired status")
+						}
+						return &domain.Authorization{}, nil
+					},
+					SetLegacyAuthorizationPasswordFn: func(_ context.Context, _, _ string) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							Status:         pointer.String("inactive"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+					Status: v1alpha1.LegacyAuthorizationStatus{
+						AtProvider: v1alpha1.LegacyAuthorizationObservation{
+							PasswordSecretVersion: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.LegacyAuthorizationsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotLegacyAuthorization": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotLegacyAuthorization),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					DeleteLegacyAuthorizationWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.LegacyAuthorization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grafana",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.LegacyAuthorizationSpec{
+						ForProvider: v1alpha1.LegacyAuthorizationParameters{
+							OrgID:          pointer.String("org"),
+							ReadBucketIDs:  []string{"b1", "b2"},
+							WriteBucketIDs: []string{"b1"},
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "grafana", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							Database: pointer.String("telegraf"),
+						},
+					},
+				},
+				api: &clients.MockLegacyAuthorizationsAPI{
+					DeleteLegacyAuthorizationWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteLegacyAuthorization),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
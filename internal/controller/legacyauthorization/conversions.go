@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package legacyauthorization
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	keyUsername = "username"
+	keyPassword = "password"
+	keyDatabase = "database"
+)
+
+// GenerateLegacyAuthorizationObservation converts an Authorization response to
+// an observation.
+func GenerateLegacyAuthorizationObservation(a *domain.Authorization) v1alpha1.LegacyAuthorizationObservation {
+	o := v1alpha1.LegacyAuthorizationObservation{
+		ID:     pointer.StringDeref(a.Id, ""),
+		Org:    pointer.StringDeref(a.Org, ""),
+		User:   pointer.StringDeref(a.User, ""),
+		UserID: pointer.StringDeref(a.UserID, ""),
+	}
+	if a.Status != nil {
+		o.Status = string(*a.Status)
+	}
+	if a.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*a.CreatedAt)
+	}
+	if a.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*a.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateLegacyAuthorization returns a LegacyAuthorizationPostRequest that the
+// InfluxDB API accepts for creation.
+func GenerateLegacyAuthorization(username string, params v1alpha1.LegacyAuthorizationParameters) *domain.LegacyAuthorizationPostRequest {
+	out := &domain.LegacyAuthorizationPostRequest{
+		AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+			Description: params.Description,
+		},
+		OrgID: params.OrgID,
+		Token: pointer.String(username),
+	}
+	if params.Status != nil {
+		s := domain.AuthorizationUpdateRequestStatus(*params.Status)
+		out.Status = &s
+	}
+	perms := make([]domain.Permission, 0, len(params.ReadBucketIDs)+len(params.WriteBucketIDs))
+	perms = append(perms, generatePermissions(domain.PermissionActionRead, params.OrgID, params.ReadBucketIDs)...)
+	perms = append(perms, generatePermissions(domain.PermissionActionWrite, params.OrgID, params.WriteBucketIDs)...)
+	out.Permissions = &perms
+	return out
+}
+
+func generatePermissions(action domain.PermissionAction, orgID *string, bucketIDs []string) []domain.Permission {
+	out := make([]domain.Permission, len(bucketIDs))
+	for i := range bucketIDs {
+		out[i] = domain.Permission{
+			Action: action,
+			Resource: domain.Resource{
+				Type:  domain.ResourceTypeBuckets,
+				Id:    pointer.String(bucketIDs[i]),
+				OrgID: orgID,
+			},
+		}
+	}
+	return out
+}
+
+// GenerateLegacyAuthorizationUpdate returns an AuthorizationUpdateRequest that
+// the InfluxDB API accepts for update.
+func GenerateLegacyAuthorizationUpdate(params v1alpha1.LegacyAuthorizationParameters) *domain.AuthorizationUpdateRequest {
+	s := domain.AuthorizationUpdateRequestStatus(pointer.StringDeref(params.Status, string(domain.AuthorizationUpdateRequestStatusActive)))
+	return &domain.AuthorizationUpdateRequest{
+		Description: params.Description,
+		Status:      &s,
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.LegacyAuthorizationParameters, obs *domain.Authorization) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(params v1alpha1.LegacyAuthorizationParameters, obs *domain.Authorization) bool {
+	observed := string(domain.AuthorizationUpdateRequestStatusActive)
+	if obs.Status != nil {
+		observed = string(*obs.Status)
+	}
+	return pointer.StringDeref(params.Status, string(domain.AuthorizationUpdateRequestStatusActive)) == observed &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "")
+}
+
+// GetConnectionDetails returns the details that v1 clients need to log in.
+func GetConnectionDetails(username string, password []byte, database *string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		keyUsername: []byte(username),
+		keyPassword: password,
+	}
+	if database != nil {
+		cd[keyDatabase] = []byte(*database)
+	}
+	return cd
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package measurementschema
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotMeasurementSchema    = "managed resource is not a MeasurementSchema custom resource"
+	errGetMeasurementSchema    = "cannot get measurement schema"
+	errCreateMeasurementSchema = "cannot create measurement schema"
+	errUpdateMeasurementSchema = "cannot update measurement schema"
+	errIncompatibleColumns     = "columns of a measurement schema can only be added, but %s"
+)
+
+// Setup adds a controller that reconciles MeasurementSchema managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.MeasurementSchemaGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.MeasurementSchemaGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.MeasurementSchema{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: clients.NewMeasurementSchemasAPI(cl.HTTPService())}, nil
+}
+
+type external struct {
+	api clients.MeasurementSchemasAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MeasurementSchema)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMeasurementSchema)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// A measurement schema cannot be deleted, it's gone as far as we're
+	// concerned.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	s, err := c.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetMeasurementSchema)
+	}
+
+	cr.Status.AtProvider = GenerateMeasurementSchemaObservation(s)
+	cr.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: IsUpToDate(cr.Spec.ForProvider, s),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MeasurementSchema)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMeasurementSchema)
+	}
+
+	p := cr.Spec.ForProvider
+	s, err := c.api.CreateMeasurementSchema(ctx, pointer.StringDeref(p.OrgID, ""), pointer.StringDeref(p.BucketID, ""), measurementName(cr), GenerateColumns(p.Columns))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMeasurementSchema)
+	}
+	meta.SetExternalName(cr, s.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MeasurementSchema)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMeasurementSchema)
+	}
+	s, err := c.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetMeasurementSchema)
+	}
+	// The API would reject the update anyway, but the error it returns does
+	// not tell which columns are the problem.
+	if bad := IncompatibleColumns(cr.Spec.ForProvider.Columns, s.Columns); len(bad) != 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errIncompatibleColumns, strings.Join(bad, ", "))
+	}
+
+	p := cr.Spec.ForProvider
+	_, err = c.api.UpdateMeasurementSchema(ctx, pointer.StringDeref(p.OrgID, ""), pointer.StringDeref(p.BucketID, ""), meta.GetExternalName(cr), GenerateColumns(p.Columns))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMeasurementSchema)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	_, ok := mg.(*v1alpha1.MeasurementSchema)
+	if !ok {
+		return errors.New(errNotMeasurementSchema)
+	}
+	// InfluxDB does not support deleting measurement schemas. The schema is
+	// removed together with its bucket. Observe reports it as gone once the
+	// resource is deleted, so this is not called.
+	return nil
+}
+
+func (c *external) get(ctx context.Context, cr *v1alpha1.MeasurementSchema) (*clients.MeasurementSchema, error) {
+	p := cr.Spec.ForProvider
+	return c.api.GetMeasurementSchema(ctx, pointer.StringDeref(p.OrgID, ""), pointer.StringDeref(p.BucketID, ""), meta.GetExternalName(cr))
+}
+
+// measurementName returns the name of the measurement in InfluxDB.
+func measurementName(cr *v1alpha1.MeasurementSchema) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,777 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package measurementschema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func observed() *clients.MeasurementSchema {
+	return &clients.MeasurementSchema{
+		ID:       "id",
+		OrgID:    "org",
+		BucketID: "bucket",
+		Name:     "cpu",
+		Columns: []clients.MeasurementSchemaColumn{
+			{Name: "host", Type: v1alpha1.ColumnTypeTag},
+			{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.MeasurementSchemasAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotMeasurementSchema": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotMeasurementSchema),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Deleted": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "cpu",
+						DeletionTimestamp: &now,
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "cpu",
+						DeletionTimestamp: &now,
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetMeasurementSchema),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, orgID, bucketID, id string) (*clients.MeasurementSchema, error) {
+						if orgID != "org" || bucketID != "bucket" || id != "id" {
+							t.Errorf("get call has to use the org, bucket and external name")
+						}
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: func() resource.Managed {
+					cr := &v1alpha1.MeasurementSchema{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cpu",
+							Annotations: map[string]string{
+								meta.AnnotationKeyExternalName: "id",
+							},
+						},
+						Spec: v1alpha1.MeasurementSchemaSpec{
+							ForProvider: v1alpha1.MeasurementSchemaParameters{
+								OrgID:    pointer.String("org"),
+								BucketID: pointer.String("bucket"),
+								Columns: []v1alpha1.MeasurementSchemaColumn{
+									{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+									{Name: "host", Type: v1alpha1.ColumnTypeTag},
+								},
+							},
+						},
+						Status: v1alpha1.MeasurementSchemaStatus{
+							AtProvider: v1alpha1.MeasurementSchemaObservation{ID: "id"},
+						},
+					}
+					cr.SetConditions(xpv1.Available())
+					return cr
+				}(),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ColumnAdded": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns:  []v1alpha1.MeasurementSchemaColumn{v1alpha1.MeasurementSchemaColumn{Name: "time", Type: v1alpha1.ColumnTypeTimestamp}, v1alpha1.MeasurementSchemaColumn{Name: "host", Type: v1alpha1.ColumnTypeTag}, v1alpha1.MeasurementSchemaColumn{Name: "usage_user", Type: v1alpha1.ColumnTypeField, DataType: pointer.String("float")}},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: func() resource.Managed {
+					cr := &v1alpha1.MeasurementSchema{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cpu",
+							Annotations: map[string]string{
+								meta.AnnotationKeyExternalName: "id",
+							},
+						},
+						Spec: v1alpha1.MeasurementSchemaSpec{
+							ForProvider: v1alpha1.MeasurementSchemaParameters{
+								OrgID:    pointer.String("org"),
+								BucketID: pointer.String("bucket"),
+								Columns:  []v1alpha1.MeasurementSchemaColumn{v1alpha1.MeasurementSchemaColumn{Name: "time", Type: v1alpha1.ColumnTypeTimestamp}, v1alpha1.MeasurementSchemaColumn{Name: "host", Type: v1alpha1.ColumnTypeTag}, v1alpha1.MeasurementSchemaColumn{Name: "usage_user", Type: v1alpha1.ColumnTypeField, DataType: pointer.String("float")}},
+							},
+						},
+						Status: v1alpha1.MeasurementSchemaStatus{
+							AtProvider: v1alpha1.MeasurementSchemaObservation{ID: "id"},
+						},
+					}
+					cr.SetConditions(xpv1.Available())
+					return cr
+				}(),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.MeasurementSchemasAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotMeasurementSchema": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotMeasurementSchema),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					CreateMeasurementSchemaFn: func(_ context.Context, _, _, _ string, _ []clients.MeasurementSchemaColumn) (*clients.MeasurementSchema, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateMeasurementSchema),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					CreateMeasurementSchemaFn: func(_ context.Context, orgID, bucketID, name string, columns []clients.MeasurementSchemaColumn) (*clients.MeasurementSchema, error) {
+						if orgID != "org" || bucketID != "bucket" {
+							t.Errorf("creation call has to use the org and bucket")
+						}
+						if name != "cpu" {
+							t.Errorf("creation call has to default the name to the name of the managed resource")
+						}
+						if len(columns) != 2 {
+							t.Errorf("creation call has to include the columns")
+						}
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.MeasurementSchemasAPI
+	}
+	type want struct {
+		err error
+		upd managed.ExternalUpdate
+	}
+
+	noUpdate := func(_ context.Context, _, _, _ string, _ []clients.MeasurementSchemaColumn) (*clients.MeasurementSchema, error) {
+		t.Errorf("incompatible columns must not be sent to the API")
+		return nil, nil
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotMeasurementSchema": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotMeasurementSchema),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetMeasurementSchema),
+			},
+		},
+		"ColumnRemoved": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns:  []v1alpha1.MeasurementSchemaColumn{v1alpha1.MeasurementSchemaColumn{Name: "time", Type: v1alpha1.ColumnTypeTimestamp}},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return observed(), nil
+					},
+					UpdateMeasurementSchemaFn: noUpdate,
+				},
+			},
+			want: want{
+				err: errors.Errorf(errIncompatibleColumns, "host is removed"),
+			},
+		},
+		"ColumnRetyped": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns:  []v1alpha1.MeasurementSchemaColumn{v1alpha1.MeasurementSchemaColumn{Name: "time", Type: v1alpha1.ColumnTypeTimestamp}, v1alpha1.MeasurementSchemaColumn{Name: "host", Type: v1alpha1.ColumnTypeField, DataType: pointer.String("string")}},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return observed(), nil
+					},
+					UpdateMeasurementSchemaFn: noUpdate,
+				},
+			},
+			want: want{
+				err: errors.Errorf(errIncompatibleColumns, "host is changed from tag to field of type string"),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return observed(), nil
+					},
+					UpdateMeasurementSchemaFn: func(_ context.Context, _, _, _ string, _ []clients.MeasurementSchemaColumn) (*clients.MeasurementSchema, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateMeasurementSchema),
+			},
+		},
+		"ColumnAdded": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns:  []v1alpha1.MeasurementSchemaColumn{v1alpha1.MeasurementSchemaColumn{Name: "time", Type: v1alpha1.ColumnTypeTimestamp}, v1alpha1.MeasurementSchemaColumn{Name: "host", Type: v1alpha1.ColumnTypeTag}, v1alpha1.MeasurementSchemaColumn{Name: "usage_user", Type: v1alpha1.ColumnTypeField, DataType: pointer.String("float")}},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{
+					GetMeasurementSchemaFn: func(_ context.Context, _, _, _ string) (*clients.MeasurementSchema, error) {
+						return observed(), nil
+					},
+					UpdateMeasurementSchemaFn: func(_ context.Context, _, _, id string, columns []clients.MeasurementSchemaColumn) (*clients.MeasurementSchema, error) {
+						if id != "id" {
+							t.Errorf("update call has to use the external name")
+						}
+						if len(columns) != 3 {
+							t.Errorf("update call has to include all columns")
+						}
+						return observed(), nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upd, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.MeasurementSchemasAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotMeasurementSchema": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotMeasurementSchema),
+			},
+		},
+		"NoOp": {
+			args: args{
+				mg: &v1alpha1.MeasurementSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cpu",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.MeasurementSchemaSpec{
+						ForProvider: v1alpha1.MeasurementSchemaParameters{
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+							Columns: []v1alpha1.MeasurementSchemaColumn{
+								{Name: "time", Type: v1alpha1.ColumnTypeTimestamp},
+								{Name: "host", Type: v1alpha1.ColumnTypeTag},
+							},
+						},
+					},
+				},
+				api: &clients.MockMeasurementSchemasAPI{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package measurementschema
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateMeasurementSchemaObservation converts a MeasurementSchema response to
+// an observation.
+func GenerateMeasurementSchemaObservation(s *clients.MeasurementSchema) v1alpha1.MeasurementSchemaObservation {
+	o := v1alpha1.MeasurementSchemaObservation{
+		ID: s.ID,
+	}
+	if s.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*s.CreatedAt)
+	}
+	if s.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*s.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateColumns returns the columns that the InfluxDB API accepts for
+// creation and update.
+func GenerateColumns(columns []v1alpha1.MeasurementSchemaColumn) []clients.MeasurementSchemaColumn {
+	out := make([]clients.MeasurementSchemaColumn, len(columns))
+	for i, c := range columns {
+		out[i] = clients.MeasurementSchemaColumn{
+			Name:     c.Name,
+			Type:     c.Type,
+			DataType: c.DataType,
+		}
+	}
+	return out
+}
+
+// IncompatibleColumns returns the descriptions of the observed columns that
+// are removed or retyped in the desired columns. InfluxDB only allows columns
+// to be added to a measurement schema.
+func IncompatibleColumns(desired []v1alpha1.MeasurementSchemaColumn, observed []clients.MeasurementSchemaColumn) []string {
+	want := make(map[string]v1alpha1.MeasurementSchemaColumn, len(desired))
+	for _, c := range desired {
+		want[c.Name] = c
+	}
+	var out []string
+	for _, o := range observed {
+		d, ok := want[o.Name]
+		switch {
+		case !ok:
+			out = append(out, fmt.Sprintf("%s is removed", o.Name))
+		case d.Type != o.Type || pointer.StringDeref(d.DataType, "") != pointer.StringDeref(o.DataType, ""):
+			out = append(out, fmt.Sprintf("%s is changed from %s to %s", o.Name, columnType(o.Type, o.DataType), columnType(d.Type, d.DataType)))
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// IsUpToDate returns whether an update call is necessary. The order of the
+// columns does not matter.
+func IsUpToDate(params v1alpha1.MeasurementSchemaParameters, obs *clients.MeasurementSchema) bool {
+	if len(params.Columns) != len(obs.Columns) {
+		return false
+	}
+	if len(IncompatibleColumns(params.Columns, obs.Columns)) != 0 {
+		return false
+	}
+	observed := make(map[string]bool, len(obs.Columns))
+	for _, c := range obs.Columns {
+		observed[c.Name] = true
+	}
+	for _, c := range params.Columns {
+		if !observed[c.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// columnType returns a readable description of the type of a column.
+func columnType(t string, dataType *string) string {
+	if dataType == nil {
+		return t
+	}
+	return t + " of type " + *dataType
+}
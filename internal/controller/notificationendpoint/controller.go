@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationendpoint
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotNotificationEndpoint    = "managed resource is not a NotificationEndpoint custom resource"
+	errGetNotificationEndpoint    = "cannot get notification endpoint"
+	errCreateNotificationEndpoint = "cannot create notification endpoint"
+	errUpdateNotificationEndpoint = "cannot update notification endpoint"
+	errDeleteNotificationEndpoint = "cannot delete notification endpoint"
+	errNoTypeConfig               = "configuration of the %s notification endpoint is missing"
+	errGetSecret                  = "cannot get Secret"
+	errSecretKeyMissing           = "referenced key does not exist in the Secret"
+)
+
+// Setup adds a controller that reconciles NotificationEndpoint managed
+// resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.NotificationEndpointGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NotificationEndpointGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.NotificationEndpoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewNotificationEndpointsAPI(rc)}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.NotificationEndpointsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNotificationEndpoint)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ne, err := c.api.GetNotificationEndpointByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetNotificationEndpoint)
+	}
+	// The referenced Secrets are often deleted together with the resource,
+	// so they are not read when only the deletion is left.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	_, version, err := c.resolveSecrets(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	obs := GenerateNotificationEndpointObservation(ne)
+	obs.SecretVersion = cr.Status.AtProvider.SecretVersion
+	cr.Status.AtProvider = obs
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.NotificationEndpointBaseStatusActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.NotificationEndpointBaseStatusInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+
+	li := LateInitialize(&cr.Spec.ForProvider, ne)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate: version == cr.Status.AtProvider.SecretVersion &&
+			IsUpToDate(endpointName(cr), cr.Spec.ForProvider, ne),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNotificationEndpoint)
+	}
+	secrets, _, err := c.resolveSecrets(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	ne, err := c.api.CreateNotificationEndpoint(ctx, GenerateNotificationEndpoint(endpointName(cr), cr.Spec.ForProvider, secrets))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNotificationEndpoint)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(ne.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NotificationEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNotificationEndpoint)
+	}
+	secrets, version, err := c.resolveSecrets(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	ne := GenerateNotificationEndpoint(endpointName(cr), cr.Spec.ForProvider, secrets)
+	ne.Id = pointer.String(meta.GetExternalName(cr))
+	if _, err := c.api.UpdateNotificationEndpoint(ctx, ne); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNotificationEndpoint)
+	}
+	cr.Status.AtProvider.SecretVersion = version
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NotificationEndpoint)
+	if !ok {
+		return errors.New(errNotNotificationEndpoint)
+	}
+	err := c.api.DeleteNotificationEndpointWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteNotificationEndpoint)
+}
+
+// resolveSecrets returns the values of the Secret keys referenced in the
+// configuration of the notification endpoint type and the version of the
+// Secrets they are read from.
+func (c *external) resolveSecrets(ctx context.Context, params v1alpha1.NotificationEndpointParameters) (map[string]string, string, error) {
+	refs := map[string]*v1.SecretKeySelector{}
+	switch params.Type {
+	case v1alpha1.NotificationEndpointTypeHTTP:
+		if params.HTTP == nil {
+			return nil, "", errors.Errorf(errNoTypeConfig, params.Type)
+		}
+		refs[keyUsername] = params.HTTP.UsernameSecretRef
+		refs[keyPassword] = params.HTTP.PasswordSecretRef
+		refs[keyToken] = params.HTTP.TokenSecretRef
+	case v1alpha1.NotificationEndpointTypeSlack:
+		if params.Slack == nil {
+			return nil, "", errors.Errorf(errNoTypeConfig, params.Type)
+		}
+		refs[keyURL] = &params.Slack.URLSecretRef
+		refs[keyToken] = params.Slack.TokenSecretRef
+	case v1alpha1.NotificationEndpointTypePagerDuty:
+		if params.PagerDuty == nil {
+			return nil, "", errors.Errorf(errNoTypeConfig, params.Type)
+		}
+		refs[keyRoutingKey] = &params.PagerDuty.RoutingKeySecretRef
+	}
+	out := map[string]string{}
+	var read []*corev1.Secret
+	for k, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		s := &corev1.Secret{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, "", errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, "", errors.New(errSecretKeyMissing)
+		}
+		out[k] = string(v)
+		read = append(read, s)
+	}
+	return out, clients.SecretVersion(read...), nil
+}
+
+// endpointName returns the name of the notification endpoint in InfluxDB.
+func endpointName(cr *v1alpha1.NotificationEndpoint) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,962 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+// observed returns the endpoint as it'd be returned by the API, i.e. without
+// the sensitive fields except for the URL.
+func observed(cr *v1alpha1.NotificationEndpoint) *clients.NotificationEndpoint {
+	e := GenerateNotificationEndpoint(endpointName(cr), cr.Spec.ForProvider, nil)
+	active := domain.NotificationEndpointBaseStatusActive
+	e.Id = pointer.String("id")
+	e.Status = &active
+	e.Description = pointer.String("")
+	e.URL = pointer.String("https://hooks.slack.com/services/x")
+	return e
+}
+
+func mockSecretGet(token, version string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.Data = map[string][]byte{"url": []byte("https://hooks.slack.com/services/x"), "token": []byte(token)}
+		s.SetResourceVersion(version)
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.NotificationEndpointsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationEndpoint": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotNotificationEndpoint),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetNotificationEndpoint),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return observed(&v1alpha1.NotificationEndpoint{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "alerts",
+							},
+							Spec: v1alpha1.NotificationEndpointSpec{
+								ForProvider: v1alpha1.NotificationEndpointParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.NotificationEndpointTypeSlack,
+									Slack: &v1alpha1.SlackEndpointParameters{
+										URLSecretRef: xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "url",
+										},
+										TokenSecretRef: &xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "token",
+										},
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+					Status: v1alpha1.NotificationEndpointStatus{
+						AtProvider: v1alpha1.NotificationEndpointObservation{
+							SecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("s3cr3t", "1")},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return observed(&v1alpha1.NotificationEndpoint{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "alerts",
+							},
+							Spec: v1alpha1.NotificationEndpointSpec{
+								ForProvider: v1alpha1.NotificationEndpointParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.NotificationEndpointTypeSlack,
+									Slack: &v1alpha1.SlackEndpointParameters{
+										URLSecretRef: xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "url",
+										},
+										TokenSecretRef: &xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "token",
+										},
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DeletedWithSecrets": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "alerts",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+					Status: v1alpha1.NotificationEndpointStatus{
+						AtProvider: v1alpha1.NotificationEndpointObservation{
+							SecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return observed(&v1alpha1.NotificationEndpoint{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "alerts",
+							},
+							Spec: v1alpha1.NotificationEndpointSpec{
+								ForProvider: v1alpha1.NotificationEndpointParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.NotificationEndpointTypeSlack,
+									Slack: &v1alpha1.SlackEndpointParameters{
+										URLSecretRef: xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "url",
+										},
+										TokenSecretRef: &xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "token",
+										},
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretChanged": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+					Status: v1alpha1.NotificationEndpointStatus{
+						AtProvider: v1alpha1.NotificationEndpointObservation{
+							SecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("n3w", "2")},
+				api: &clients.MockNotificationEndpointsAPI{
+					GetNotificationEndpointByIDFn: func(_ context.Context, _ string) (*clients.NotificationEndpoint, error) {
+						return observed(&v1alpha1.NotificationEndpoint{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "alerts",
+							},
+							Spec: v1alpha1.NotificationEndpointSpec{
+								ForProvider: v1alpha1.NotificationEndpointParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.NotificationEndpointTypeSlack,
+									Slack: &v1alpha1.SlackEndpointParameters{
+										URLSecretRef: xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "url",
+										},
+										TokenSecretRef: &xpv1.SecretKeySelector{
+											SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+											Key:             "token",
+										},
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.NotificationEndpointsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationEndpoint": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotNotificationEndpoint),
+			},
+		},
+		"MissingTypeConfig": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{Type: v1alpha1.NotificationEndpointTypePagerDuty},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{Type: v1alpha1.NotificationEndpointTypePagerDuty},
+					},
+				},
+				err: errors.Errorf(errNoTypeConfig, v1alpha1.NotificationEndpointTypePagerDuty),
+			},
+		},
+		"SecretKeyMissing": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				err: errors.New(errSecretKeyMissing),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("s3cr3t", "1")},
+				api: &clients.MockNotificationEndpointsAPI{
+					CreateNotificationEndpointFn: func(_ context.Context, _ *clients.NotificationEndpoint) (*clients.NotificationEndpoint, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateNotificationEndpoint),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("s3cr3t", "1")},
+				api: &clients.MockNotificationEndpointsAPI{
+					CreateNotificationEndpointFn: func(_ context.Context, e *clients.NotificationEndpoint) (*clients.NotificationEndpoint, error) {
+						if pointer.StringDeref(e.URL, "") != "https://hooks.slack.com/services/x" || pointer.StringDeref(e.Token, "") != "s3cr3t" {
+							t.Errorf("creation call has to include the URL and the token from the Secret")
+						}
+						return &clients.NotificationEndpoint{NotificationEndpointBase: domain.NotificationEndpointBase{Id: pointer.String("id")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.NotificationEndpointsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationEndpoint": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotNotificationEndpoint),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("s3cr3t", "1")},
+				api: &clients.MockNotificationEndpointsAPI{
+					UpdateNotificationEndpointFn: func(_ context.Context, _ *clients.NotificationEndpoint) (*clients.NotificationEndpoint, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errUpdateNotificationEndpoint),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+					Status: v1alpha1.NotificationEndpointStatus{
+						AtProvider: v1alpha1.NotificationEndpointObservation{
+							SecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: mockSecretGet("s3cr3t", "2")},
+				api: &clients.MockNotificationEndpointsAPI{
+					UpdateNotificationEndpointFn: func(_ context.Context, e *clients.NotificationEndpoint) (*clients.NotificationEndpoint, error) {
+						if pointer.StringDeref(e.Id, "") != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if pointer.StringDeref(e.Token, "") != "s3cr3t" {
+							t.Errorf("update call has to include the token from the Secret")
+						}
+						return e, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+					Status: v1alpha1.NotificationEndpointStatus{
+						AtProvider: v1alpha1.NotificationEndpointObservation{
+							SecretVersion: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.NotificationEndpointsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationEndpoint": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotNotificationEndpoint),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				api: &clients.MockNotificationEndpointsAPI{
+					DeleteNotificationEndpointWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "alerts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.NotificationEndpointSpec{
+						ForProvider: v1alpha1.NotificationEndpointParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.NotificationEndpointTypeSlack,
+							Slack: &v1alpha1.SlackEndpointParameters{
+								URLSecretRef: xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "url",
+								},
+								TokenSecretRef: &xpv1.SecretKeySelector{
+									SecretReference: xpv1.SecretReference{Name: "slack", Namespace: "ns"},
+									Key:             "token",
+								},
+							},
+						},
+					},
+				},
+				api: &clients.MockNotificationEndpointsAPI{
+					DeleteNotificationEndpointWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteNotificationEndpoint),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
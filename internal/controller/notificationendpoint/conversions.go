@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationendpoint
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// Keys of the resolved secret values.
+const (
+	keyURL        = "url"
+	keyUsername   = "username"
+	keyPassword   = "password"
+	keyToken      = "token"
+	keyRoutingKey = "routingKey"
+)
+
+// GenerateNotificationEndpointObservation converts a NotificationEndpoint
+// response to an observation.
+func GenerateNotificationEndpointObservation(e *clients.NotificationEndpoint) v1alpha1.NotificationEndpointObservation {
+	o := v1alpha1.NotificationEndpointObservation{
+		ID:     pointer.StringDeref(e.Id, ""),
+		UserID: pointer.StringDeref(e.UserID, ""),
+	}
+	if e.Status != nil {
+		o.Status = string(*e.Status)
+	}
+	if e.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*e.CreatedAt)
+	}
+	if e.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*e.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateNotificationEndpoint returns a NotificationEndpoint model that the
+// InfluxDB API accepts for creation and update. Sensitive fields are filled
+// from the given resolved secret values.
+func GenerateNotificationEndpoint(name string, params v1alpha1.NotificationEndpointParameters, secrets map[string]string) *clients.NotificationEndpoint {
+	out := &clients.NotificationEndpoint{
+		NotificationEndpointBase: domain.NotificationEndpointBase{
+			Name:        name,
+			Description: params.Description,
+			OrgID:       params.OrgID,
+			Type:        domain.NotificationEndpointType(params.Type),
+		},
+	}
+	if params.Status != nil {
+		s := domain.NotificationEndpointBaseStatus(*params.Status)
+		out.Status = &s
+	}
+	switch params.Type {
+	case v1alpha1.NotificationEndpointTypeHTTP:
+		if params.HTTP == nil {
+			break
+		}
+		out.URL = pointer.String(params.HTTP.URL)
+		out.Method = pointer.String(params.HTTP.Method)
+		out.AuthMethod = pointer.String(params.HTTP.AuthMethod)
+		out.Headers = params.HTTP.Headers
+		out.ContentTemplate = params.HTTP.ContentTemplate
+		out.Username = secretPtr(secrets, keyUsername)
+		out.Password = secretPtr(secrets, keyPassword)
+		out.Token = secretPtr(secrets, keyToken)
+	case v1alpha1.NotificationEndpointTypeSlack:
+		if params.Slack == nil {
+			break
+		}
+		out.URL = secretPtr(secrets, keyURL)
+		out.Token = secretPtr(secrets, keyToken)
+	case v1alpha1.NotificationEndpointTypePagerDuty:
+		if params.PagerDuty == nil {
+			break
+		}
+		out.ClientURL = params.PagerDuty.ClientURL
+		out.RoutingKey = secretPtr(secrets, keyRoutingKey)
+	}
+	return out
+}
+
+func secretPtr(secrets map[string]string, key string) *string {
+	v, ok := secrets[key]
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.NotificationEndpointParameters, obs *clients.NotificationEndpoint) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary. The API does not
+// return the sensitive fields, so they are not compared here.
+func IsUpToDate(name string, params v1alpha1.NotificationEndpointParameters, obs *clients.NotificationEndpoint) bool {
+	desired := GenerateNotificationEndpoint(name, params, nil)
+	// The URL of slack endpoints is a credential that is read from a Secret.
+	if desired.Type != domain.NotificationEndpointTypeSlack &&
+		pointer.StringDeref(desired.URL, "") != pointer.StringDeref(obs.URL, "") {
+		return false
+	}
+	return desired.Name == obs.Name &&
+		desired.Type == obs.Type &&
+		pointer.StringDeref(desired.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		endpointStatus(desired.Status) == endpointStatus(obs.Status) &&
+		pointer.StringDeref(desired.Method, "") == pointer.StringDeref(obs.Method, "") &&
+		pointer.StringDeref(desired.AuthMethod, "") == pointer.StringDeref(obs.AuthMethod, "") &&
+		isHeadersUpToDate(desired.Headers, obs.Headers) &&
+		pointer.StringDeref(desired.ContentTemplate, "") == pointer.StringDeref(obs.ContentTemplate, "") &&
+		pointer.StringDeref(desired.ClientURL, "") == pointer.StringDeref(obs.ClientURL, "")
+}
+
+func isHeadersUpToDate(desired, obs map[string]string) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for k, v := range desired {
+		if ov, ok := obs[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// endpointStatus returns the given status with the default of the API filled
+// in.
+func endpointStatus(s *domain.NotificationEndpointBaseStatus) domain.NotificationEndpointBaseStatus {
+	if s == nil {
+		return domain.NotificationEndpointBaseStatusActive
+	}
+	return *s
+}
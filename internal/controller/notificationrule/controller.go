@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationrule
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotNotificationRule    = "managed resource is not a NotificationRule custom resource"
+	errGetNotificationRule    = "cannot get notification rule"
+	errCreateNotificationRule = "cannot create notification rule"
+	errUpdateNotificationRule = "cannot update notification rule"
+	errDeleteNotificationRule = "cannot delete notification rule"
+)
+
+// Setup adds a controller that reconciles NotificationRule managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.NotificationRuleGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NotificationRuleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.NotificationRule{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: clients.NewNotificationRulesAPI(rc)}, nil
+}
+
+type external struct {
+	api clients.NotificationRulesAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNotificationRule)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rule, err := c.api.GetNotificationRuleByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetNotificationRule)
+	}
+
+	cr.Status.AtProvider = GenerateNotificationRuleObservation(rule)
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.TaskStatusTypeActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.TaskStatusTypeInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+	li := LateInitialize(&cr.Spec.ForProvider, rule)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        IsUpToDate(ruleName(cr), cr.Spec.ForProvider, rule),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNotificationRule)
+	}
+	rule, err := c.api.CreateNotificationRule(ctx, GenerateNotificationRule(ruleName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNotificationRule)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(rule.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NotificationRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNotificationRule)
+	}
+	desired := GenerateNotificationRule(ruleName(cr), cr.Spec.ForProvider)
+	desired.Id = pointer.String(meta.GetExternalName(cr))
+	_, err := c.api.UpdateNotificationRule(ctx, desired)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNotificationRule)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NotificationRule)
+	if !ok {
+		return errors.New(errNotNotificationRule)
+	}
+	err := c.api.DeleteNotificationRuleWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteNotificationRule)
+}
+
+// ruleName returns the name of the notification rule in InfluxDB.
+func ruleName(cr *v1alpha1.NotificationRule) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
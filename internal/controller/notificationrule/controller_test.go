@@ -0,0 +1,699 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+// observed returns the notification rule as it'd be returned by the API.
+func observed(cr *v1alpha1.NotificationRule) *clients.NotificationRule {
+	r := GenerateNotificationRule(ruleName(cr), cr.Spec.ForProvider)
+	r.Id = pointer.String("id")
+	r.Description = pointer.String("")
+	r.Offset = pointer.String("")
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.NotificationRulesAPI
+	}
+	type want struct {
+		err   error
+		obs   managed.ExternalObservation
+		ready xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationRule": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotNotificationRule),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetNotificationRule),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDateActive": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							TagRules:        []v1alpha1.TagRule{v1alpha1.TagRule{Key: "host", Value: "a", Operator: "equal"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return observed(&v1alpha1.NotificationRule{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "crit",
+							},
+							Spec: v1alpha1.NotificationRuleSpec{
+								ForProvider: v1alpha1.NotificationRuleParameters{
+									OrgID:           pointer.String("org"),
+									EndpointID:      pointer.String("endpoint"),
+									Type:            "slack",
+									Every:           pointer.String("10m"),
+									StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+									TagRules:        []v1alpha1.TagRule{v1alpha1.TagRule{Key: "host", Value: "a", Operator: "equal"}},
+									MessageTemplate: pointer.String("${ r._message }"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				ready: xpv1.Available(),
+			},
+		},
+		"Inactive": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Status:          pointer.String("inactive"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return observed(&v1alpha1.NotificationRule{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "crit",
+							},
+							Spec: v1alpha1.NotificationRuleSpec{
+								ForProvider: v1alpha1.NotificationRuleParameters{
+									OrgID:           pointer.String("org"),
+									EndpointID:      pointer.String("endpoint"),
+									Status:          pointer.String("inactive"),
+									Type:            "slack",
+									Every:           pointer.String("10m"),
+									StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+									MessageTemplate: pointer.String("${ r._message }"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				ready: xpv1.Unavailable(),
+			},
+		},
+		"StatusRulesChanged": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{v1alpha1.StatusRule{CurrentLevel: "CRIT", PreviousLevel: pointer.String("OK")}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return observed(&v1alpha1.NotificationRule{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "crit",
+							},
+							Spec: v1alpha1.NotificationRuleSpec{
+								ForProvider: v1alpha1.NotificationRuleParameters{
+									OrgID:           pointer.String("org"),
+									EndpointID:      pointer.String("endpoint"),
+									Type:            "slack",
+									Every:           pointer.String("10m"),
+									StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+									MessageTemplate: pointer.String("${ r._message }"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+				ready: xpv1.Available(),
+			},
+		},
+		"TagRulesChanged": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					GetNotificationRuleByIDFn: func(_ context.Context, _ string) (*clients.NotificationRule, error) {
+						return observed(&v1alpha1.NotificationRule{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "crit",
+							},
+							Spec: v1alpha1.NotificationRuleSpec{
+								ForProvider: v1alpha1.NotificationRuleParameters{
+									OrgID:           pointer.String("org"),
+									EndpointID:      pointer.String("endpoint"),
+									Type:            "slack",
+									Every:           pointer.String("10m"),
+									StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+									TagRules:        []v1alpha1.TagRule{v1alpha1.TagRule{Key: "host", Value: "a", Operator: "equal"}},
+									MessageTemplate: pointer.String("${ r._message }"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+				ready: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.ready.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.ready, tc.args.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.NotificationRulesAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationRule": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotNotificationRule),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					CreateNotificationRuleFn: func(_ context.Context, _ *clients.NotificationRule) (*clients.NotificationRule, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateNotificationRule),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					CreateNotificationRuleFn: func(_ context.Context, r *clients.NotificationRule) (*clients.NotificationRule, error) {
+						if r.EndpointID != "endpoint" || r.OrgID != "org" {
+							t.Errorf("creation call has to include the endpoint and org")
+						}
+						if len(r.StatusRules) != 1 || *r.StatusRules[0].CurrentLevel != domain.RuleStatusLevelCRIT {
+							t.Errorf("creation call has to include the status rules")
+						}
+						return &clients.NotificationRule{NotificationRuleBase: domain.NotificationRuleBase{Id: pointer.String("id")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.NotificationRulesAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationRule": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotNotificationRule),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					UpdateNotificationRuleFn: func(_ context.Context, _ *clients.NotificationRule) (*clients.NotificationRule, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateNotificationRule),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Status:          pointer.String("inactive"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					UpdateNotificationRuleFn: func(_ context.Context, r *clients.NotificationRule) (*clients.NotificationRule, error) {
+						if pointer.StringDeref(r.Id, "") != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if r.Status != domain.TaskStatusTypeInactive {
+							t.Errorf("update call has to include the desired status")
+						}
+						return r, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.NotificationRulesAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNotificationRule": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotNotificationRule),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					DeleteNotificationRuleWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.NotificationRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crit",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.NotificationRuleSpec{
+						ForProvider: v1alpha1.NotificationRuleParameters{
+							OrgID:           pointer.String("org"),
+							EndpointID:      pointer.String("endpoint"),
+							Type:            "slack",
+							Every:           pointer.String("10m"),
+							StatusRules:     []v1alpha1.StatusRule{{CurrentLevel: "CRIT"}},
+							MessageTemplate: pointer.String("${ r._message }"),
+						},
+					},
+				},
+				api: &clients.MockNotificationRulesAPI{
+					DeleteNotificationRuleWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteNotificationRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationrule
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateNotificationRuleObservation converts a NotificationRule response to
+// an observation.
+func GenerateNotificationRuleObservation(r *clients.NotificationRule) v1alpha1.NotificationRuleObservation {
+	o := v1alpha1.NotificationRuleObservation{
+		ID:           pointer.StringDeref(r.Id, ""),
+		OwnerID:      pointer.StringDeref(r.OwnerID, ""),
+		TaskID:       pointer.StringDeref(r.TaskID, ""),
+		Status:       string(r.Status),
+		LastRunError: pointer.StringDeref(r.LastRunError, ""),
+	}
+	if r.LastRunStatus != nil {
+		o.LastRunStatus = string(*r.LastRunStatus)
+	}
+	if r.LatestCompleted != nil {
+		lc := metav1.NewTime(*r.LatestCompleted)
+		o.LatestCompleted = &lc
+	}
+	if r.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*r.CreatedAt)
+	}
+	if r.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*r.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateNotificationRule returns a NotificationRule model that the InfluxDB
+// API accepts for creation and update.
+func GenerateNotificationRule(name string, params v1alpha1.NotificationRuleParameters) *clients.NotificationRule {
+	out := &clients.NotificationRule{
+		NotificationRuleBase: domain.NotificationRuleBase{
+			Name:        name,
+			Description: params.Description,
+			OrgID:       pointer.StringDeref(params.OrgID, ""),
+			EndpointID:  pointer.StringDeref(params.EndpointID, ""),
+			Every:       params.Every,
+			Offset:      params.Offset,
+			Status:      domain.TaskStatusType(pointer.StringDeref(params.Status, string(domain.TaskStatusTypeActive))),
+			StatusRules: make([]domain.StatusRule, len(params.StatusRules)),
+		},
+		Type:            params.Type,
+		MessageTemplate: params.MessageTemplate,
+		Channel:         params.Channel,
+	}
+	for i, sr := range params.StatusRules {
+		cur := domain.RuleStatusLevel(sr.CurrentLevel)
+		out.StatusRules[i] = domain.StatusRule{CurrentLevel: &cur}
+		if sr.PreviousLevel != nil {
+			prev := domain.RuleStatusLevel(*sr.PreviousLevel)
+			out.StatusRules[i].PreviousLevel = &prev
+		}
+	}
+	if len(params.TagRules) != 0 {
+		tr := make([]domain.TagRule, len(params.TagRules))
+		for i, t := range params.TagRules {
+			op := domain.TagRuleOperator(t.Operator)
+			tr[i] = domain.TagRule{
+				Key:      pointer.String(t.Key),
+				Value:    pointer.String(t.Value),
+				Operator: &op,
+			}
+		}
+		out.TagRules = &tr
+	}
+	return out
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.NotificationRuleParameters, obs *clients.NotificationRule) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	params.Every = li.LateInitializeStringPtr(params.Every, obs.Every)
+	params.Offset = li.LateInitializeStringPtr(params.Offset, obs.Offset)
+	if params.Status == nil && obs.Status != "" {
+		params.Status = pointer.String(string(obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name string, params v1alpha1.NotificationRuleParameters, obs *clients.NotificationRule) bool {
+	desired := GenerateNotificationRule(name, params)
+	return desired.Name == obs.Name &&
+		desired.Type == obs.Type &&
+		desired.EndpointID == obs.EndpointID &&
+		pointer.StringDeref(desired.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		pointer.StringDeref(desired.Every, "") == pointer.StringDeref(obs.Every, "") &&
+		pointer.StringDeref(desired.Offset, "") == pointer.StringDeref(obs.Offset, "") &&
+		ruleStatus(desired.Status) == ruleStatus(obs.Status) &&
+		isStatusRulesUpToDate(desired.StatusRules, obs.StatusRules) &&
+		isTagRulesUpToDate(tagRules(desired.TagRules), tagRules(obs.TagRules)) &&
+		pointer.StringDeref(desired.MessageTemplate, "") == pointer.StringDeref(obs.MessageTemplate, "") &&
+		pointer.StringDeref(desired.Channel, "") == pointer.StringDeref(obs.Channel, "")
+}
+
+func isStatusRulesUpToDate(desired, obs []domain.StatusRule) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for i := range desired {
+		d, o := desired[i], obs[i]
+		if pointer.IntDeref(d.Count, 0) != pointer.IntDeref(o.Count, 0) ||
+			pointer.StringDeref(d.Period, "") != pointer.StringDeref(o.Period, "") ||
+			ruleLevel(d.CurrentLevel) != ruleLevel(o.CurrentLevel) ||
+			ruleLevel(d.PreviousLevel) != ruleLevel(o.PreviousLevel) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTagRulesUpToDate(desired, obs []domain.TagRule) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for i := range desired {
+		d, o := desired[i], obs[i]
+		if pointer.StringDeref(d.Key, "") != pointer.StringDeref(o.Key, "") ||
+			pointer.StringDeref(d.Value, "") != pointer.StringDeref(o.Value, "") ||
+			tagRuleOperator(d.Operator) != tagRuleOperator(o.Operator) {
+			return false
+		}
+	}
+	return true
+}
+
+// ruleStatus returns the given status with the default of the API filled in.
+func ruleStatus(s domain.TaskStatusType) domain.TaskStatusType {
+	if s == "" {
+		return domain.TaskStatusTypeActive
+	}
+	return s
+}
+
+func ruleLevel(l *domain.RuleStatusLevel) domain.RuleStatusLevel {
+	if l == nil {
+		return ""
+	}
+	return *l
+}
+
+func tagRules(r *[]domain.TagRule) []domain.TagRule {
+	if r == nil {
+		return nil
+	}
+	return *r
+}
+
+func tagRuleOperator(o *domain.TagRuleOperator) domain.TagRuleOperator {
+	if o == nil {
+		return ""
+	}
+	return *o
+}
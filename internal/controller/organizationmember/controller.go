@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationmember
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotOrganizationMember = "managed resource is not an OrganizationMember custom resource"
+	errGetMembers            = "cannot get members of organization"
+	errGetOwners             = "cannot get owners of organization"
+	errAddMember             = "cannot add member to organization"
+	errAddOwner              = "cannot add owner to organization"
+	errRemoveMember          = "cannot remove member from organization"
+	errRemoveOwner           = "cannot remove owner from organization"
+)
+
+// Setup adds a controller that reconciles OrganizationMember managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OrganizationMemberGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationMemberGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.OrganizationMember{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: cl.OrganizationsAPI()}, nil
+}
+
+type external struct {
+	api clients.OrganizationMembersAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationMember)
+	}
+	orgID := pointer.StringDeref(cr.Spec.ForProvider.OrgID, "")
+	userID := pointer.StringDeref(cr.Spec.ForProvider.UserID, "")
+
+	members, err := c.api.GetMembersWithID(ctx, orgID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMembers)
+	}
+	owners, err := c.api.GetOwnersWithID(ctx, orgID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetOwners)
+	}
+	member := FindMember(members, userID)
+	owner := FindOwner(owners, userID)
+	if member == nil && owner == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = GenerateOrganizationMemberObservation(member, owner)
+	cr.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: IsUpToDate(cr.Spec.ForProvider, member, owner),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationMember)
+	}
+	return managed.ExternalCreation{}, c.add(ctx, cr.Spec.ForProvider)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationMember)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationMember)
+	}
+	// The only thing that can change is the role, so we add the user with the
+	// desired role and remove the other one.
+	if err := c.add(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	orgID := pointer.StringDeref(cr.Spec.ForProvider.OrgID, "")
+	userID := pointer.StringDeref(cr.Spec.ForProvider.UserID, "")
+	if cr.Spec.ForProvider.Role == v1alpha1.OrganizationRoleOwner {
+		err := c.api.RemoveMemberWithID(ctx, orgID, userID)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errRemoveMember)
+	}
+	err := c.api.RemoveOwnerWithID(ctx, orgID, userID)
+	return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errRemoveOwner)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationMember)
+	if !ok {
+		return errors.New(errNotOrganizationMember)
+	}
+	orgID := pointer.StringDeref(cr.Spec.ForProvider.OrgID, "")
+	userID := pointer.StringDeref(cr.Spec.ForProvider.UserID, "")
+	if err := c.api.RemoveMemberWithID(ctx, orgID, userID); resource.Ignore(clients.IsNotFound, err) != nil {
+		return errors.Wrap(err, errRemoveMember)
+	}
+	err := c.api.RemoveOwnerWithID(ctx, orgID, userID)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errRemoveOwner)
+}
+
+func (c *external) add(ctx context.Context, params v1alpha1.OrganizationMemberParameters) error {
+	orgID := pointer.StringDeref(params.OrgID, "")
+	userID := pointer.StringDeref(params.UserID, "")
+	if params.Role == v1alpha1.OrganizationRoleOwner {
+		_, err := c.api.AddOwnerWithID(ctx, orgID, userID)
+		return errors.Wrap(err, errAddOwner)
+	}
+	_, err := c.api.AddMemberWithID(ctx, orgID, userID)
+	return errors.Wrap(err, errAddMember)
+}
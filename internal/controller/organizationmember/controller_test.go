@@ -0,0 +1,333 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationmember
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func member(role string) *v1alpha1.OrganizationMember {
+	return &v1alpha1.OrganizationMember{
+		Spec: v1alpha1.OrganizationMemberSpec{
+			ForProvider: v1alpha1.OrganizationMemberParameters{
+				OrgID:  pointer.String("org"),
+				UserID: pointer.String("user"),
+				Role:   role,
+			},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	noOwners := func(_ context.Context, _ string) (*[]domain.ResourceOwner, error) {
+		return &[]domain.ResourceOwner{}, nil
+	}
+	type args struct {
+		mg  resource.Managed
+		api clients.OrganizationMembersAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationMember": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationMember),
+			},
+		},
+		"GetMembersFailed": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					GetMembersWithIDFn: func(_ context.Context, _ string) (*[]domain.ResourceMember, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetMembers),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					GetMembersWithIDFn: func(_ context.Context, _ string) (*[]domain.ResourceMember, error) {
+						return &[]domain.ResourceMember{{UserResponse: domain.UserResponse{Id: pointer.String("other")}}}, nil
+					},
+					GetOwnersWithIDFn: noOwners,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					GetMembersWithIDFn: func(_ context.Context, _ string) (*[]domain.ResourceMember, error) {
+						return &[]domain.ResourceMember{{UserResponse: domain.UserResponse{Id: pointer.String("user")}}}, nil
+					},
+					GetOwnersWithIDFn: noOwners,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RoleChanged": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleOwner),
+				api: &clients.MockOrganizationMembersAPI{
+					GetMembersWithIDFn: func(_ context.Context, _ string) (*[]domain.ResourceMember, error) {
+						return &[]domain.ResourceMember{{UserResponse: domain.UserResponse{Id: pointer.String("user")}}}, nil
+					},
+					GetOwnersWithIDFn: noOwners,
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.OrganizationMembersAPI
+	}
+	type want struct {
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationMember": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationMember),
+			},
+		},
+		"AddMemberFailed": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					AddMemberWithIDFn: func(_ context.Context, _, _ string) (*domain.ResourceMember, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errAddMember),
+			},
+		},
+		"AddOwner": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleOwner),
+				api: &clients.MockOrganizationMembersAPI{
+					AddOwnerWithIDFn: func(_ context.Context, orgID, userID string) (*domain.ResourceOwner, error) {
+						if orgID != "org" || userID != "user" {
+							t.Errorf("owner has to be added to the given org")
+						}
+						return &domain.ResourceOwner{}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.OrganizationMembersAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationMember": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationMember),
+			},
+		},
+		"PromoteToOwner": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleOwner),
+				api: &clients.MockOrganizationMembersAPI{
+					AddOwnerWithIDFn: func(_ context.Context, _, _ string) (*domain.ResourceOwner, error) {
+						return &domain.ResourceOwner{}, nil
+					},
+					RemoveMemberWithIDFn: func(_ context.Context, _, _ string) error {
+						return nil
+					},
+				},
+			},
+		},
+		"RemoveOwnerFailed": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					AddMemberWithIDFn: func(_ context.Context, _, _ string) (*domain.ResourceMember, error) {
+						return &domain.ResourceMember{}, nil
+					},
+					RemoveOwnerWithIDFn: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRemoveOwner),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.OrganizationMembersAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationMember": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationMember),
+			},
+		},
+		"RemoveMemberFailed": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					RemoveMemberWithIDFn: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRemoveMember),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: member(v1alpha1.OrganizationRoleMember),
+				api: &clients.MockOrganizationMembersAPI{
+					RemoveMemberWithIDFn: func(_ context.Context, _, _ string) error {
+						return nil
+					},
+					RemoveOwnerWithIDFn: func(_ context.Context, _, _ string) error {
+						return nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationmember
+
+import (
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// FindMember returns the member with the given user ID from the list or nil
+// if it is not found.
+func FindMember(members *[]domain.ResourceMember, userID string) *domain.ResourceMember {
+	if members == nil {
+		return nil
+	}
+	for i := range *members {
+		if pointer.StringDeref((*members)[i].Id, "") == userID {
+			return &(*members)[i]
+		}
+	}
+	return nil
+}
+
+// FindOwner returns the owner with the given user ID from the list or nil if
+// it is not found.
+func FindOwner(owners *[]domain.ResourceOwner, userID string) *domain.ResourceOwner {
+	if owners == nil {
+		return nil
+	}
+	for i := range *owners {
+		if pointer.StringDeref((*owners)[i].Id, "") == userID {
+			return &(*owners)[i]
+		}
+	}
+	return nil
+}
+
+// GenerateOrganizationMemberObservation converts the member and owner entries
+// of a user to an observation. Ownership takes precedence if the user appears
+// in both lists.
+func GenerateOrganizationMemberObservation(member *domain.ResourceMember, owner *domain.ResourceOwner) v1alpha1.OrganizationMemberObservation {
+	var u domain.UserResponse
+	o := v1alpha1.OrganizationMemberObservation{}
+	switch {
+	case owner != nil:
+		u = owner.UserResponse
+		o.Role = v1alpha1.OrganizationRoleOwner
+	case member != nil:
+		u = member.UserResponse
+		o.Role = v1alpha1.OrganizationRoleMember
+	}
+	o.UserName = u.Name
+	if u.Status != nil {
+		o.Status = string(*u.Status)
+	}
+	return o
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(params v1alpha1.OrganizationMemberParameters, member *domain.ResourceMember, owner *domain.ResourceOwner) bool {
+	if params.Role == v1alpha1.OrganizationRoleOwner {
+		return owner != nil && member == nil
+	}
+	return member != nil && owner == nil
+}
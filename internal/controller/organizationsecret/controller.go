@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationsecret
+
+import (
+	"context"
+	"sort"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotOrganizationSecret = "managed resource is not an OrganizationSecret custom resource"
+	errGetSecretKeys         = "cannot get secret keys of organization"
+	errPutSecrets            = "cannot put secrets to organization"
+	errDeleteSecrets         = "cannot delete secrets of organization"
+	errGetSecret             = "cannot get referenced Secret"
+)
+
+// Setup adds a controller that reconciles OrganizationSecret managed
+// resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OrganizationSecretGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationSecretGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.OrganizationSecret{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewOrganizationSecretsAPI(rc)}, nil
+}
+
+// external syncs the keys of a Secret into the secret store of an org. The
+// external name is the ID of the org. Errors never include the values so that
+// they do not end up in events.
+type external struct {
+	kube client.Client
+	api  clients.OrganizationSecretsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationSecret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationSecret)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	keys, err := c.api.GetSecretKeys(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetSecretKeys)
+	}
+	// The referenced Secret is often deleted together with the resource, so
+	// it is not read when only the deletion is left.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	secrets, version, err := c.getSecrets(ctx, cr.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: IsUpToDate(secrets, version, keys, cr.Status.AtProvider),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationSecret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationSecret)
+	}
+	secrets, _, err := c.getSecrets(ctx, cr.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	orgID := pointer.StringDeref(cr.Spec.ForProvider.OrgID, "")
+	if len(secrets) != 0 {
+		if err := c.api.PutSecrets(ctx, orgID, secrets); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPutSecrets)
+		}
+	}
+	meta.SetExternalName(cr, orgID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationSecret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationSecret)
+	}
+	orgID := meta.GetExternalName(cr)
+	keys, err := c.api.GetSecretKeys(ctx, orgID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSecretKeys)
+	}
+	secrets, version, err := c.getSecrets(ctx, cr.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The API does not return the values, so we cannot tell which of them
+	// changed. All of them are written, which adds the missing keys and
+	// overwrites the changed ones.
+	_, removed := DiffKeys(secrets, keys, cr.Status.AtProvider.Keys)
+	if len(secrets) != 0 {
+		if err := c.api.PutSecrets(ctx, orgID, secrets); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutSecrets)
+		}
+	}
+	if len(removed) != 0 {
+		if err := c.api.DeleteSecrets(ctx, orgID, removed); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteSecrets)
+		}
+	}
+	cr.Status.AtProvider = GenerateOrganizationSecretObservation(secrets, version)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationSecret)
+	if !ok {
+		return errors.New(errNotOrganizationSecret)
+	}
+	// The keys may not be recorded in status yet if the resource is deleted
+	// before its first update, so the keys of the Secret are deleted too if
+	// it still exists.
+	keys := map[string]bool{}
+	for _, k := range cr.Status.AtProvider.Keys {
+		keys[k] = true
+	}
+	secrets, _, err := c.getSecrets(ctx, cr.Spec.ForProvider.SecretRef)
+	if resource.Ignore(kerrors.IsNotFound, errors.Cause(err)) != nil {
+		return err
+	}
+	for k := range secrets {
+		keys[k] = true
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	del := make([]string, 0, len(keys))
+	for k := range keys {
+		del = append(del, k)
+	}
+	sort.Strings(del)
+	err = c.api.DeleteSecrets(ctx, meta.GetExternalName(cr), del)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteSecrets)
+}
+
+// getSecrets returns the key/value pairs and the version of the referenced
+// Secret.
+func (c *external) getSecrets(ctx context.Context, ref v1.SecretReference) (map[string]string, string, error) {
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, "", errors.Wrap(err, errGetSecret)
+	}
+	out := make(map[string]string, len(s.Data))
+	for k, v := range s.Data {
+		out[k] = string(v)
+	}
+	return out, clients.SecretVersion(s), nil
+}
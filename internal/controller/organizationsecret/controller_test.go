@@ -0,0 +1,725 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationsecret
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+// kube returns a client that returns a Secret with the given resource version
+// and data.
+func kube(version string, data map[string]string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetResourceVersion(version)
+			s.Data = map[string][]byte{}
+			for k, v := range data {
+				s.Data[k] = []byte(v)
+			}
+			return nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.OrganizationSecretsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationSecret": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationSecret),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetKeysFailed": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecretKeys),
+			},
+		},
+		"OrgNotFound": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a", "b"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("1", map[string]string{"a": "1", "b": "2"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a", "b", "unmanaged"}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValueChanged": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("2", map[string]string{"a": "2"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a"}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedWithSecret": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "task-secrets",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "s"))},
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a"}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"KeyRemoved": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("1", map[string]string{"a": "1", "b": "2"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a"}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.OrganizationSecretsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationSecret": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotOrganizationSecret),
+			},
+		},
+		"PutFailed": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				kube: kube("1", map[string]string{"a": "1"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					PutSecretsFn: func(_ context.Context, _ string, _ map[string]string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errPutSecrets),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+				kube: kube("1", map[string]string{"a": "1"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					PutSecretsFn: func(_ context.Context, orgID string, s map[string]string) error {
+						if orgID != "org" {
+							t.Errorf("put call has to use the org ID")
+						}
+						if diff := cmp.Diff(map[string]string{"a": "1"}, s); diff != "" {
+							t.Errorf("put call has to include the values of the Secret: %s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.OrganizationSecretsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationSecret": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotOrganizationSecret),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a", "b"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("1", map[string]string{"a": "1"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a", "b"}, nil
+					},
+					PutSecretsFn: func(_ context.Context, _ string, _ map[string]string) error {
+						return nil
+					},
+					DeleteSecretsFn: func(_ context.Context, _ string, _ []string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a", "b"}, SecretVersion: "1"},
+					},
+				},
+				err: errors.Wrap(errBoom, errDeleteSecrets),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a", "b"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("2", map[string]string{"a": "3", "c": "4"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					GetSecretKeysFn: func(_ context.Context, _ string) ([]string, error) {
+						return []string{"a", "b", "unmanaged"}, nil
+					},
+					PutSecretsFn: func(_ context.Context, _ string, s map[string]string) error {
+						if diff := cmp.Diff(map[string]string{"a": "3", "c": "4"}, s); diff != "" {
+							t.Errorf("put call has to include the values of the Secret: %s", diff)
+						}
+						return nil
+					},
+					DeleteSecretsFn: func(_ context.Context, _ string, keys []string) error {
+						if diff := cmp.Diff([]string{"b"}, keys); diff != "" {
+							t.Errorf("only removed keys that were applied before have to be deleted: %s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a", "c"}, SecretVersion: "2"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.OrganizationSecretsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotOrganizationSecret": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotOrganizationSecret),
+			},
+		},
+		"DeleteAppliedAndCurrentKeys": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("1", map[string]string{"b": "2"}),
+				api: &clients.MockOrganizationSecretsAPI{
+					DeleteSecretsFn: func(_ context.Context, orgID string, keys []string) error {
+						if orgID != "org" {
+							t.Errorf("deletion call has to use the org ID")
+						}
+						if diff := cmp.Diff([]string{"a", "b"}, keys); diff != "" {
+							t.Errorf("deletion call has to include all managed keys: %s", diff)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"SecretGone": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "s"))},
+				api: &clients.MockOrganizationSecretsAPI{
+					DeleteSecretsFn: func(_ context.Context, _ string, keys []string) error {
+						if diff := cmp.Diff([]string{"a"}, keys); diff != "" {
+							t.Errorf("deletion call has to include the applied keys: %s", diff)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.OrganizationSecret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "task-secrets",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "org",
+						},
+					},
+					Spec: v1alpha1.OrganizationSecretSpec{
+						ForProvider: v1alpha1.OrganizationSecretParameters{
+							OrgID:     pointer.String("org"),
+							SecretRef: xpv1.SecretReference{Name: "s", Namespace: "ns"},
+						},
+					},
+					Status: v1alpha1.OrganizationSecretStatus{
+						AtProvider: v1alpha1.OrganizationSecretObservation{Keys: []string{"a"}, SecretVersion: "1"},
+					},
+				},
+				kube: kube("1", nil),
+				api: &clients.MockOrganizationSecretsAPI{
+					DeleteSecretsFn: func(_ context.Context, _ string, _ []string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteSecrets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{kube: tc.args.kube, api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
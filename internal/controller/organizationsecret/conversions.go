@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationsecret
+
+import (
+	"sort"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// GenerateOrganizationSecretObservation returns the observation that records
+// the given secrets as applied. Only the keys and the given version of the
+// Secret are stored.
+func GenerateOrganizationSecretObservation(secrets map[string]string, version string) v1alpha1.OrganizationSecretObservation {
+	o := v1alpha1.OrganizationSecretObservation{
+		SecretVersion: version,
+	}
+	if len(secrets) != 0 {
+		o.Keys = make([]string, 0, len(secrets))
+		for k := range secrets {
+			o.Keys = append(o.Keys, k)
+		}
+		sort.Strings(o.Keys)
+	}
+	return o
+}
+
+// DiffKeys returns the desired keys that are missing in the secret store and
+// the keys that were applied before but are not desired anymore. Keys in the
+// secret store that were not applied by us are left alone.
+func DiffKeys(desired map[string]string, observed []string, applied []string) (missing, removed []string) {
+	exists := make(map[string]bool, len(observed))
+	for _, k := range observed {
+		exists[k] = true
+	}
+	for k := range desired {
+		if !exists[k] {
+			missing = append(missing, k)
+		}
+	}
+	for _, k := range applied {
+		if _, ok := desired[k]; !ok && exists[k] {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(removed)
+	return missing, removed
+}
+
+// IsUpToDate returns whether an update call is necessary. Values are
+// considered changed if the version of the Secret changed.
+func IsUpToDate(desired map[string]string, version string, observed []string, obs v1alpha1.OrganizationSecretObservation) bool {
+	missing, removed := DiffKeys(desired, observed, obs.Keys)
+	return len(missing) == 0 && len(removed) == 0 && version == obs.SecretVersion
+}
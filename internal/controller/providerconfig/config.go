@@ -32,7 +32,7 @@ import (
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage and checking the health of their InfluxDB instance.
 func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := providerconfig.ControllerName(v1alpha1.ProviderConfigGroupKind)
 
@@ -50,7 +50,13 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		WithOptions(o).
 		For(&v1alpha1.ProviderConfig{}).
 		Watches(&source.Kind{Type: &v1alpha1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
-		Complete(providerconfig.NewReconciler(mgr, of,
-			providerconfig.WithLogger(l.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		Complete(&healthReconciler{
+			usage: providerconfig.NewReconciler(mgr, of,
+				providerconfig.WithLogger(l.WithValues("controller", name)),
+				providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+			kube:         mgr.GetClient(),
+			newHealthAPI: newHealthAPI,
+			interval:     healthCheckInterval,
+			log:          l.WithValues("controller", name),
+		})
 }
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	reconcileTimeout    = 1 * time.Minute
+	healthCheckInterval = 1 * time.Minute
+)
+
+const (
+	errGetPC        = "cannot get ProviderConfig"
+	errUpdateStatus = "cannot update ProviderConfig status"
+	errNotReady     = "InfluxDB is not ready yet"
+)
+
+// A healthReconciler checks whether the InfluxDB instance of a ProviderConfig
+// can be used once the wrapped reconciler has accounted for its usage. The
+// check is repeated periodically, so that a misconfigured endpoint or
+// credentials show up on the ProviderConfig itself.
+type healthReconciler struct {
+	usage        reconcile.Reconciler
+	kube         client.Client
+	newHealthAPI func(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (clients.HealthAPI, error)
+	interval     time.Duration
+	log          logging.Logger
+}
+
+// Reconcile a ProviderConfig.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.usage.Reconcile(ctx, req)
+	if err != nil || res.Requeue || res.RequeueAfter != 0 {
+		return res, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	r.check(ctx, pc)
+	r.log.Debug("Checked health of InfluxDB", "request", req, "reason", pc.GetCondition(xpv1.TypeReady).Reason)
+	return reconcile.Result{RequeueAfter: r.interval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+// check sets the Ready condition of the ProviderConfig and records the
+// version of its InfluxDB instance.
+func (r *healthReconciler) check(ctx context.Context, pc *v1alpha1.ProviderConfig) {
+	api, err := r.newHealthAPI(ctx, r.kube, pc)
+	if err != nil {
+		pc.SetConditions(v1alpha1.Misconfigured().WithMessage(err.Error()))
+		return
+	}
+
+	h, err := api.GetHealth(ctx)
+	if err != nil {
+		pc.SetConditions(unavailable(err))
+		return
+	}
+	pc.Status.Version = pointer.StringDeref(h.Version, "")
+	pc.Status.Commit = pointer.StringDeref(h.Commit, "")
+	pc.Status.Build = h.Build
+	if h.Status != domain.HealthCheckStatusPass {
+		pc.SetConditions(v1alpha1.Unhealthy().WithMessage(pointer.StringDeref(h.Message, "")))
+		return
+	}
+
+	rd, err := api.GetReady(ctx)
+	if err != nil {
+		pc.SetConditions(unavailable(err))
+		return
+	}
+	if rd.Status == nil || *rd.Status != domain.ReadyStatusReady {
+		pc.SetConditions(v1alpha1.Unhealthy().WithMessage(errNotReady))
+		return
+	}
+
+	if err := api.CheckAuthorization(ctx); err != nil {
+		pc.SetConditions(unavailable(err))
+		return
+	}
+	pc.SetConditions(v1alpha1.Healthy())
+}
+
+// unavailable returns the condition for a failed request to InfluxDB. It is
+// unreachable if it did not respond at all. Any request can be rejected as
+// unauthorized, since the provider signs in before the first one in Session
+// mode.
+func unavailable(err error) xpv1.Condition {
+	if clients.IsUnauthorized(err) {
+		return v1alpha1.Unauthorized().WithMessage(err.Error())
+	}
+	if hErr, ok := err.(*apihttp.Error); ok && hErr.StatusCode != 0 {
+		return v1alpha1.Unhealthy().WithMessage(err.Error())
+	}
+	return v1alpha1.Unreachable().WithMessage(err.Error())
+}
+
+// newHealthAPI returns a HealthAPI that uses the endpoint and the credentials
+// of the given ProviderConfig.
+func newHealthAPI(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (clients.HealthAPI, error) {
+	cl, err := clients.NewProviderConfigClient(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	return clients.NewHealthAPI(cl.HTTPService()), nil
+}
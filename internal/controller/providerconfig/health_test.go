@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func healthyAPI() *clients.MockHealthAPI {
+	return &clients.MockHealthAPI{
+		GetHealthFn: func(_ context.Context) (*clients.Health, error) {
+			return &clients.Health{
+				HealthCheck: domain.HealthCheck{
+					Status:  domain.HealthCheckStatusPass,
+					Version: pointer.String("v2.1.1"),
+					Commit:  pointer.String("657e1839de"),
+				},
+				Build: "OSS",
+			}, nil
+		},
+		GetReadyFn: func(_ context.Context) (*domain.Ready, error) {
+			s := domain.ReadyStatusReady
+			return &domain.Ready{Status: &s}, nil
+		},
+		CheckAuthorizationFn: func(_ context.Context) error {
+			return nil
+		},
+	}
+}
+
+func withStatus(s v1alpha1.ProviderConfigStatus, c xpv1.Condition) v1alpha1.ProviderConfigStatus {
+	s.SetConditions(c)
+	return s
+}
+
+func TestReconcile(t *testing.T) {
+	version := v1alpha1.ProviderConfigStatus{Version: "v2.1.1", Commit: "657e1839de", Build: "OSS"}
+
+	type args struct {
+		usage  reconcile.Reconciler
+		get    error
+		api    clients.HealthAPI
+		apiErr error
+		upd    error
+	}
+	type want struct {
+		res    reconcile.Result
+		err    error
+		status *v1alpha1.ProviderConfigStatus
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UsageFailed": {
+			args: args{
+				usage: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, errBoom
+				}),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"UsageRequeued": {
+			args: args{
+				usage: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{RequeueAfter: 30}, nil
+				}),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: 30},
+			},
+		},
+		"NotFound": {
+			args: args{
+				get: kerrors.NewNotFound(schema.GroupResource{}, "pc"),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				get: errBoom,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPC),
+			},
+		},
+		"Misconfigured": {
+			args: args{
+				apiErr: errBoom,
+			},
+			want: want{
+				res:    reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: &v1alpha1.ProviderConfigStatus{ProviderConfigStatus: xpv1.ProviderConfigStatus{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{v1alpha1.Misconfigured().WithMessage(errBoom.Error())}}}},
+			},
+		},
+		"Unreachable": {
+			args: args{
+				api: &clients.MockHealthAPI{
+					GetHealthFn: func(_ context.Context) (*clients.Health, error) {
+						return nil, apihttp.NewError(errBoom)
+					},
+				},
+			},
+			want: want{
+				res:    reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: &v1alpha1.ProviderConfigStatus{ProviderConfigStatus: xpv1.ProviderConfigStatus{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{v1alpha1.Unreachable().WithMessage(errBoom.Error())}}}},
+			},
+		},
+		"SigninRejected": {
+			args: args{
+				api: &clients.MockHealthAPI{
+					GetHealthFn: func(_ context.Context) (*clients.Health, error) {
+						return nil, apihttp.NewError(&apihttp.Error{StatusCode: 401, Err: errBoom})
+					},
+				},
+			},
+			want: want{
+				res:    reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: &v1alpha1.ProviderConfigStatus{ProviderConfigStatus: xpv1.ProviderConfigStatus{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{v1alpha1.Unauthorized().WithMessage(errBoom.Error())}}}},
+			},
+		},
+		"Unhealthy": {
+			args: args{
+				api: &clients.MockHealthAPI{
+					GetHealthFn: func(_ context.Context) (*clients.Health, error) {
+						return &clients.Health{
+							HealthCheck: domain.HealthCheck{
+								Status:  domain.HealthCheckStatusFail,
+								Message: pointer.String("failing"),
+								Version: pointer.String("v2.1.1"),
+								Commit:  pointer.String("657e1839de"),
+							},
+							Build: "OSS",
+						}, nil
+					},
+				},
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: func() *v1alpha1.ProviderConfigStatus {
+					s := withStatus(version, v1alpha1.Unhealthy().WithMessage("failing"))
+					return &s
+				}(),
+			},
+		},
+		"NotReady": {
+			args: args{
+				api: func() clients.HealthAPI {
+					api := healthyAPI()
+					api.GetReadyFn = func(_ context.Context) (*domain.Ready, error) {
+						return &domain.Ready{}, nil
+					}
+					return api
+				}(),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: func() *v1alpha1.ProviderConfigStatus {
+					s := withStatus(version, v1alpha1.Unhealthy().WithMessage(errNotReady))
+					return &s
+				}(),
+			},
+		},
+		"Unauthorized": {
+			args: args{
+				api: func() clients.HealthAPI {
+					api := healthyAPI()
+					api.CheckAuthorizationFn = func(_ context.Context) error {
+						return &apihttp.Error{StatusCode: 401, Code: "unauthorized", Message: "unauthorized access"}
+					}
+					return api
+				}(),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: func() *v1alpha1.ProviderConfigStatus {
+					s := withStatus(version, v1alpha1.Unauthorized().WithMessage("unauthorized: unauthorized access"))
+					return &s
+				}(),
+			},
+		},
+		"Healthy": {
+			args: args{
+				api: healthyAPI(),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: healthCheckInterval},
+				status: func() *v1alpha1.ProviderConfigStatus {
+					s := withStatus(version, v1alpha1.Healthy())
+					return &s
+				}(),
+			},
+		},
+		"UpdateStatusFailed": {
+			args: args{
+				api: healthyAPI(),
+				upd: errBoom,
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: healthCheckInterval},
+				err: errors.Wrap(errBoom, errUpdateStatus),
+				status: func() *v1alpha1.ProviderConfigStatus {
+					s := withStatus(version, v1alpha1.Healthy())
+					return &s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			usage := tc.args.usage
+			if usage == nil {
+				usage = reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				})
+			}
+			var status *v1alpha1.ProviderConfigStatus
+			r := &healthReconciler{
+				usage: usage,
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(tc.args.get),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						status = &obj.(*v1alpha1.ProviderConfig).Status
+						return tc.args.upd
+					},
+				},
+				newHealthAPI: func(_ context.Context, _ client.Client, _ *v1alpha1.ProviderConfig) (clients.HealthAPI, error) {
+					return tc.args.api, tc.args.apiErr
+				},
+				interval: healthCheckInterval,
+				log:      logging.NewNopLogger(),
+			}
+			res, err := r.Reconcile(context.TODO(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile(...): -want status, +got status:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconnection
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotRemoteConnection    = "managed resource is not a RemoteConnection custom resource"
+	errGetRemoteConnection    = "cannot get remote connection"
+	errCreateRemoteConnection = "cannot create remote connection"
+	errUpdateRemoteConnection = "cannot update remote connection"
+	errDeleteRemoteConnection = "cannot delete remote connection"
+	errGetTokenSecret         = "cannot get token secret"
+	errTokenKeyMissing        = "token key is not found in the referenced secret"
+)
+
+// Setup adds a controller that reconciles RemoteConnection managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.RemoteConnectionGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RemoteConnectionGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.RemoteConnection{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewRemoteConnectionsAPI(cl.HTTPService())}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.RemoteConnectionsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RemoteConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRemoteConnection)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	r, err := c.api.GetRemoteConnectionByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetRemoteConnection)
+	}
+	// The token Secret is often deleted together with the resource, so it is
+	// not read when only the deletion is left.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	s, err := c.getTokenSecret(ctx, cr.Spec.ForProvider.TokenSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	obs := GenerateRemoteConnectionObservation(r)
+	obs.TokenSecretVersion = cr.Status.AtProvider.TokenSecretVersion
+	cr.Status.AtProvider = obs
+	cr.SetConditions(v1.Available())
+	li := LateInitialize(&cr.Spec.ForProvider, r)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate: clients.SecretVersion(s) == cr.Status.AtProvider.TokenSecretVersion &&
+			IsUpToDate(remoteConnectionName(cr), cr.Spec.ForProvider, r),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RemoteConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRemoteConnection)
+	}
+	s, err := c.getTokenSecret(ctx, cr.Spec.ForProvider.TokenSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	token := string(s.Data[cr.Spec.ForProvider.TokenSecretRef.Key])
+	r, err := c.api.CreateRemoteConnection(ctx, GenerateRemoteConnectionRequest(remoteConnectionName(cr), token, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRemoteConnection)
+	}
+	meta.SetExternalName(cr, r.ID)
+	cr.Status.AtProvider.TokenSecretVersion = clients.SecretVersion(s)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RemoteConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRemoteConnection)
+	}
+	s, err := c.getTokenSecret(ctx, cr.Spec.ForProvider.TokenSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	token := string(s.Data[cr.Spec.ForProvider.TokenSecretRef.Key])
+	if _, err := c.api.UpdateRemoteConnection(ctx, meta.GetExternalName(cr), GenerateRemoteConnectionRequest(remoteConnectionName(cr), token, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRemoteConnection)
+	}
+	cr.Status.AtProvider.TokenSecretVersion = clients.SecretVersion(s)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RemoteConnection)
+	if !ok {
+		return errors.New(errNotRemoteConnection)
+	}
+	err := c.api.DeleteRemoteConnectionWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteRemoteConnection)
+}
+
+func (c *external) getTokenSecret(ctx context.Context, ref v1.SecretKeySelector) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetTokenSecret)
+	}
+	if _, ok := s.Data[ref.Key]; !ok {
+		return nil, errors.New(errTokenKeyMissing)
+	}
+	return s, nil
+}
+
+// remoteConnectionName returns the name of the remote connection in InfluxDB.
+func remoteConnectionName(cr *v1alpha1.RemoteConnection) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,793 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func observed() *clients.RemoteConnection {
+	return &clients.RemoteConnection{
+		ID:          "id",
+		Name:        "central",
+		OrgID:       "org",
+		RemoteURL:   "https://central:8086",
+		RemoteOrgID: "remote-org",
+	}
+}
+
+// kube returns a client that returns a token Secret with the given resource
+// version.
+func kube(version string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetResourceVersion(version)
+			s.Data = map[string][]byte{"token": []byte("secret")}
+			return nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.RemoteConnectionsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotRemoteConnection": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotRemoteConnection),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetRemoteConnection),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"TokenKeyMissing": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				err: errors.New(errTokenKeyMissing),
+			},
+		},
+		"DeletedSecretMissing": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "central",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("secrets"), "s"))},
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				kube: kube("1"),
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TokenChanged": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				kube: kube("2"),
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"RemoteURLChanged": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				kube: kube("1"),
+				api: &clients.MockRemoteConnectionsAPI{
+					GetRemoteConnectionByIDFn: func(_ context.Context, _ string) (*clients.RemoteConnection, error) {
+						r := observed()
+						r.RemoteURL = "https://old:8086"
+						return r, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.RemoteConnectionsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotRemoteConnection": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotRemoteConnection),
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetTokenSecret),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				kube: kube("1"),
+				api: &clients.MockRemoteConnectionsAPI{
+					CreateRemoteConnectionFn: func(_ context.Context, _ clients.RemoteConnectionRequest) (*clients.RemoteConnection, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateRemoteConnection),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				kube: kube("1"),
+				api: &clients.MockRemoteConnectionsAPI{
+					CreateRemoteConnectionFn: func(_ context.Context, r clients.RemoteConnectionRequest) (*clients.RemoteConnection, error) {
+						if r.RemoteAPIToken != "secret" {
+							t.Errorf("creation call has to include the token in the Secret")
+						}
+						if r.Name != "central" || r.OrgID != "org" {
+							t.Errorf("creation call has to use the name and the org of the remote connection")
+						}
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.RemoteConnectionsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotRemoteConnection": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotRemoteConnection),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				kube: kube("2"),
+				api: &clients.MockRemoteConnectionsAPI{
+					UpdateRemoteConnectionFn: func(_ context.Context, _ string, _ clients.RemoteConnectionRequest) (*clients.RemoteConnection, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errUpdateRemoteConnection),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "1",
+						},
+					},
+				},
+				kube: kube("2"),
+				api: &clients.MockRemoteConnectionsAPI{
+					UpdateRemoteConnectionFn: func(_ context.Context, id string, r clients.RemoteConnectionRequest) (*clients.RemoteConnection, error) {
+						if id != "id" {
+							t.Errorf("update call has to use the ID of the remote connection")
+						}
+						if r.RemoteAPIToken != "secret" {
+							t.Errorf("update call has to include the token in the Secret")
+						}
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+					Status: v1alpha1.RemoteConnectionStatus{
+						AtProvider: v1alpha1.RemoteConnectionObservation{
+							TokenSecretVersion: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.RemoteConnectionsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotRemoteConnection": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotRemoteConnection),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				api: &clients.MockRemoteConnectionsAPI{
+					DeleteRemoteConnectionWithIDFn: func(_ context.Context, id string) error {
+						if id != "id" {
+							t.Errorf("deletion call has to use the ID of the remote connection")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				api: &clients.MockRemoteConnectionsAPI{
+					DeleteRemoteConnectionWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.RemoteConnection{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "central",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.RemoteConnectionSpec{
+						ForProvider: v1alpha1.RemoteConnectionParameters{
+							OrgID:          pointer.String("org"),
+							RemoteURL:      "https://central:8086",
+							RemoteOrgID:    "remote-org",
+							TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"},
+						},
+					},
+				},
+				api: &clients.MockRemoteConnectionsAPI{
+					DeleteRemoteConnectionWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteRemoteConnection),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
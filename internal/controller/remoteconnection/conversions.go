@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconnection
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateRemoteConnectionObservation converts a RemoteConnection response to
+// an observation.
+func GenerateRemoteConnectionObservation(r *clients.RemoteConnection) v1alpha1.RemoteConnectionObservation {
+	return v1alpha1.RemoteConnectionObservation{
+		ID: r.ID,
+	}
+}
+
+// GenerateRemoteConnectionRequest returns a request that the InfluxDB API
+// accepts for creation and update.
+func GenerateRemoteConnectionRequest(name, token string, params v1alpha1.RemoteConnectionParameters) clients.RemoteConnectionRequest {
+	return clients.RemoteConnectionRequest{
+		Name:             name,
+		OrgID:            pointer.StringDeref(params.OrgID, ""),
+		Description:      params.Description,
+		RemoteURL:        params.RemoteURL,
+		RemoteAPIToken:   token,
+		RemoteOrgID:      params.RemoteOrgID,
+		AllowInsecureTLS: pointer.BoolDeref(params.AllowInsecureTLS, false),
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.RemoteConnectionParameters, obs *clients.RemoteConnection) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	params.AllowInsecureTLS = li.LateInitializeBoolPtr(params.AllowInsecureTLS, pointer.Bool(obs.AllowInsecureTLS))
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary. The token is not
+// returned by the API, so it is not compared here.
+func IsUpToDate(name string, params v1alpha1.RemoteConnectionParameters, obs *clients.RemoteConnection) bool {
+	return name == obs.Name &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		params.RemoteURL == obs.RemoteURL &&
+		params.RemoteOrgID == obs.RemoteOrgID &&
+		pointer.BoolDeref(params.AllowInsecureTLS, false) == obs.AllowInsecureTLS
+}
@@ -0,0 +1,680 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func observed() *clients.Replication {
+	return &clients.Replication{
+		ID:                    "id",
+		Name:                  "edge-metrics",
+		OrgID:                 "org",
+		RemoteID:              "remote",
+		LocalBucketID:         "bucket",
+		RemoteBucketID:        "remote-bucket",
+		MaxQueueSizeBytes:     67108860,
+		CurrentQueueSizeBytes: 1024,
+		LatestResponseCode:    pointer.Int32(http.StatusServiceUnavailable),
+		LatestErrorMessage:    pointer.String("remote is unavailable"),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ReplicationsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	status := v1alpha1.ReplicationObservation{
+		ID:                    "id",
+		CurrentQueueSizeBytes: 1024,
+		LatestResponseCode:    http.StatusServiceUnavailable,
+		LatestErrorMessage:    "remote is unavailable",
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotReplication": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotReplication),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					GetReplicationByIDFn: func(_ context.Context, _ string) (*clients.Replication, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetReplication),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					GetReplicationByIDFn: func(_ context.Context, _ string) (*clients.Replication, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"LateInitializedWithQueueStatus": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					GetReplicationByIDFn: func(_ context.Context, _ string) (*clients.Replication, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:                pointer.String("org"),
+							LocalBucketID:        pointer.String("bucket"),
+							RemoteID:             pointer.String("remote"),
+							RemoteBucketID:       "remote-bucket",
+							MaxQueueSizeBytes:    pointer.Int64(67108860),
+							DropNonRetryableData: pointer.Bool(false),
+						},
+					},
+					Status: v1alpha1.ReplicationStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: status,
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"QueueSizeChanged": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:                pointer.String("org"),
+							LocalBucketID:        pointer.String("bucket"),
+							RemoteID:             pointer.String("remote"),
+							RemoteBucketID:       "remote-bucket",
+							MaxQueueSizeBytes:    pointer.Int64(1 << 30),
+							DropNonRetryableData: pointer.Bool(false),
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					GetReplicationByIDFn: func(_ context.Context, _ string) (*clients.Replication, error) {
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:                pointer.String("org"),
+							LocalBucketID:        pointer.String("bucket"),
+							RemoteID:             pointer.String("remote"),
+							RemoteBucketID:       "remote-bucket",
+							MaxQueueSizeBytes:    pointer.Int64(1 << 30),
+							DropNonRetryableData: pointer.Bool(false),
+						},
+					},
+					Status: v1alpha1.ReplicationStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: status,
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ReplicationsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotReplication": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotReplication),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					CreateReplicationFn: func(_ context.Context, _ clients.ReplicationRequest) (*clients.Replication, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateReplication),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:                pointer.String("org"),
+							LocalBucketID:        pointer.String("bucket"),
+							RemoteID:             pointer.String("remote"),
+							RemoteBucketID:       "remote-bucket",
+							DropNonRetryableData: pointer.Bool(true),
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					CreateReplicationFn: func(_ context.Context, r clients.ReplicationRequest) (*clients.Replication, error) {
+						want := clients.ReplicationRequest{
+							Name:                 "edge-metrics",
+							OrgID:                "org",
+							RemoteID:             "remote",
+							LocalBucketID:        "bucket",
+							RemoteBucketID:       "remote-bucket",
+							DropNonRetryableData: pointer.Bool(true),
+						}
+						if diff := cmp.Diff(want, r); diff != "" {
+							t.Errorf("creation call has to include the parameters: %s", diff)
+						}
+						return observed(), nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:                pointer.String("org"),
+							LocalBucketID:        pointer.String("bucket"),
+							RemoteID:             pointer.String("remote"),
+							RemoteBucketID:       "remote-bucket",
+							DropNonRetryableData: pointer.Bool(true),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ReplicationsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotReplication": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotReplication),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					UpdateReplicationFn: func(_ context.Context, _ string, _ clients.ReplicationRequest) (*clients.Replication, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateReplication),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:             pointer.String("org"),
+							LocalBucketID:     pointer.String("bucket"),
+							RemoteID:          pointer.String("remote"),
+							RemoteBucketID:    "remote-bucket",
+							MaxQueueSizeBytes: pointer.Int64(1 << 30),
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					UpdateReplicationFn: func(_ context.Context, id string, r clients.ReplicationRequest) (*clients.Replication, error) {
+						if id != "id" {
+							t.Errorf("update call has to use the ID of the replication")
+						}
+						if pointer.Int64Deref(r.MaxQueueSizeBytes, 0) != 1<<30 {
+							t.Errorf("update call has to include the desired queue size")
+						}
+						return observed(), nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ReplicationsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotReplication": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotReplication),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					DeleteReplicationWithIDFn: func(_ context.Context, id string) error {
+						if id != "id" {
+							t.Errorf("deletion call has to use the ID of the replication")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					DeleteReplicationWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Replication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "edge-metrics",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ReplicationSpec{
+						ForProvider: v1alpha1.ReplicationParameters{
+							OrgID:          pointer.String("org"),
+							LocalBucketID:  pointer.String("bucket"),
+							RemoteID:       pointer.String("remote"),
+							RemoteBucketID: "remote-bucket",
+						},
+					},
+				},
+				api: &clients.MockReplicationsAPI{
+					DeleteReplicationWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteReplication),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
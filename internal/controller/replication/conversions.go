@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateReplicationObservation converts a Replication response to an
+// observation.
+func GenerateReplicationObservation(r *clients.Replication) v1alpha1.ReplicationObservation {
+	o := v1alpha1.ReplicationObservation{
+		ID:                    r.ID,
+		CurrentQueueSizeBytes: r.CurrentQueueSizeBytes,
+		LatestErrorMessage:    pointer.StringDeref(r.LatestErrorMessage, ""),
+	}
+	if r.LatestResponseCode != nil {
+		o.LatestResponseCode = *r.LatestResponseCode
+	}
+	return o
+}
+
+// GenerateReplicationRequest returns a request that the InfluxDB API accepts
+// for creation and update.
+func GenerateReplicationRequest(name string, params v1alpha1.ReplicationParameters) clients.ReplicationRequest {
+	return clients.ReplicationRequest{
+		Name:                 name,
+		Description:          params.Description,
+		OrgID:                pointer.StringDeref(params.OrgID, ""),
+		RemoteID:             pointer.StringDeref(params.RemoteID, ""),
+		LocalBucketID:        pointer.StringDeref(params.LocalBucketID, ""),
+		RemoteBucketID:       params.RemoteBucketID,
+		MaxQueueSizeBytes:    params.MaxQueueSizeBytes,
+		DropNonRetryableData: params.DropNonRetryableData,
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.ReplicationParameters, obs *clients.Replication) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	params.MaxQueueSizeBytes = li.LateInitializeInt64Ptr(params.MaxQueueSizeBytes, pointer.Int64(obs.MaxQueueSizeBytes))
+	params.DropNonRetryableData = li.LateInitializeBoolPtr(params.DropNonRetryableData, pointer.Bool(obs.DropNonRetryableData))
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name string, params v1alpha1.ReplicationParameters, obs *clients.Replication) bool {
+	return name == obs.Name &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		pointer.StringDeref(params.RemoteID, "") == obs.RemoteID &&
+		params.RemoteBucketID == obs.RemoteBucketID &&
+		pointer.Int64Deref(params.MaxQueueSizeBytes, obs.MaxQueueSizeBytes) == obs.MaxQueueSizeBytes &&
+		pointer.BoolDeref(params.DropNonRetryableData, false) == obs.DropNonRetryableData
+}
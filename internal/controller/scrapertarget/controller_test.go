@@ -0,0 +1,600 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scrapertarget
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+// observed returns the scraper target as it'd be returned by the API.
+func observed(cr *v1alpha1.ScraperTarget) *domain.ScraperTargetResponse {
+	req := GenerateScraperTargetRequest(scraperTargetName(cr), cr.Spec.ForProvider)
+	req.AllowInsecure = pointer.Bool(pointer.BoolDeref(req.AllowInsecure, false))
+	return &domain.ScraperTargetResponse{
+		ScraperTargetRequest: req,
+		Id:                   pointer.String("id"),
+		Bucket:               pointer.String("metrics"),
+		Org:                  pointer.String("example"),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ScraperTargetsAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotScraperTarget": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotScraperTarget),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					GetScraperTargetByIDFn: func(_ context.Context, _ string) (*domain.ScraperTargetResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetScraperTarget),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					GetScraperTargetByIDFn: func(_ context.Context, _ string) (*domain.ScraperTargetResponse, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					GetScraperTargetByIDFn: func(_ context.Context, _ string) (*domain.ScraperTargetResponse, error) {
+						return observed(&v1alpha1.ScraperTarget{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "node-exporter",
+							},
+							Spec: v1alpha1.ScraperTargetSpec{
+								ForProvider: v1alpha1.ScraperTargetParameters{
+									URL:      "http://node-exporter:9100/metrics",
+									Type:     "prometheus",
+									OrgID:    pointer.String("org"),
+									BucketID: pointer.String("bucket"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"URLChanged": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:           "http://node-exporter:9200/metrics",
+							Type:          "prometheus",
+							AllowInsecure: pointer.Bool(false),
+							OrgID:         pointer.String("org"),
+							BucketID:      pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					GetScraperTargetByIDFn: func(_ context.Context, _ string) (*domain.ScraperTargetResponse, error) {
+						return observed(&v1alpha1.ScraperTarget{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "node-exporter",
+							},
+							Spec: v1alpha1.ScraperTargetSpec{
+								ForProvider: v1alpha1.ScraperTargetParameters{
+									URL:      "http://node-exporter:9100/metrics",
+									Type:     "prometheus",
+									OrgID:    pointer.String("org"),
+									BucketID: pointer.String("bucket"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"AllowInsecureChanged": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:           "http://node-exporter:9100/metrics",
+							Type:          "prometheus",
+							AllowInsecure: pointer.Bool(true),
+							OrgID:         pointer.String("org"),
+							BucketID:      pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					GetScraperTargetByIDFn: func(_ context.Context, _ string) (*domain.ScraperTargetResponse, error) {
+						return observed(&v1alpha1.ScraperTarget{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "node-exporter",
+							},
+							Spec: v1alpha1.ScraperTargetSpec{
+								ForProvider: v1alpha1.ScraperTargetParameters{
+									URL:      "http://node-exporter:9100/metrics",
+									Type:     "prometheus",
+									OrgID:    pointer.String("org"),
+									BucketID: pointer.String("bucket"),
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ScraperTargetsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotScraperTarget": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotScraperTarget),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					CreateScraperTargetFn: func(_ context.Context, _ domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateScraperTarget),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					CreateScraperTargetFn: func(_ context.Context, r domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+						if pointer.StringDeref(r.OrgID, "") != "org" || pointer.StringDeref(r.BucketID, "") != "bucket" {
+							t.Errorf("creation call has to include the org and bucket")
+						}
+						return &domain.ScraperTargetResponse{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ScraperTargetsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotScraperTarget": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotScraperTarget),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					UpdateScraperTargetFn: func(_ context.Context, _ string, _ domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateScraperTarget),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:           "http://node-exporter:9100/metrics",
+							Type:          "prometheus",
+							AllowInsecure: pointer.Bool(true),
+							OrgID:         pointer.String("org"),
+							BucketID:      pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					UpdateScraperTargetFn: func(_ context.Context, id string, r domain.ScraperTargetRequest) (*domain.ScraperTargetResponse, error) {
+						if id != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if !pointer.BoolDeref(r.AllowInsecure, false) {
+							t.Errorf("update call has to include allowInsecure")
+						}
+						return &domain.ScraperTargetResponse{}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.ScraperTargetsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotScraperTarget": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotScraperTarget),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					DeleteScraperTargetWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.ScraperTarget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-exporter",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.ScraperTargetSpec{
+						ForProvider: v1alpha1.ScraperTargetParameters{
+							URL:      "http://node-exporter:9100/metrics",
+							Type:     "prometheus",
+							OrgID:    pointer.String("org"),
+							BucketID: pointer.String("bucket"),
+						},
+					},
+				},
+				api: &clients.MockScraperTargetsAPI{
+					DeleteScraperTargetWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteScraperTarget),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scrapertarget
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// GenerateScraperTargetObservation converts a ScraperTarget response to an
+// observation.
+func GenerateScraperTargetObservation(t *domain.ScraperTargetResponse) v1alpha1.ScraperTargetObservation {
+	return v1alpha1.ScraperTargetObservation{
+		ID:     pointer.StringDeref(t.Id, ""),
+		Bucket: pointer.StringDeref(t.Bucket, ""),
+		Org:    pointer.StringDeref(t.Org, ""),
+	}
+}
+
+// GenerateScraperTargetRequest returns a ScraperTargetRequest that the
+// InfluxDB API accepts for creation and update.
+func GenerateScraperTargetRequest(name string, params v1alpha1.ScraperTargetParameters) domain.ScraperTargetRequest {
+	t := domain.ScraperTargetRequestType(params.Type)
+	return domain.ScraperTargetRequest{
+		Name:          pointer.String(name),
+		Url:           pointer.String(params.URL),
+		Type:          &t,
+		AllowInsecure: params.AllowInsecure,
+		OrgID:         params.OrgID,
+		BucketID:      params.BucketID,
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.ScraperTargetParameters, obs *domain.ScraperTargetResponse) bool {
+	li := resource.NewLateInitializer()
+	params.AllowInsecure = li.LateInitializeBoolPtr(params.AllowInsecure, obs.AllowInsecure)
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name string, params v1alpha1.ScraperTargetParameters, obs *domain.ScraperTargetResponse) bool {
+	desired := GenerateScraperTargetRequest(name, params)
+	return pointer.StringDeref(desired.Name, "") == pointer.StringDeref(obs.Name, "") &&
+		pointer.StringDeref(desired.Url, "") == pointer.StringDeref(obs.Url, "") &&
+		scraperTargetType(desired.Type) == scraperTargetType(obs.Type) &&
+		pointer.BoolDeref(desired.AllowInsecure, false) == pointer.BoolDeref(obs.AllowInsecure, false) &&
+		pointer.StringDeref(desired.OrgID, "") == pointer.StringDeref(obs.OrgID, "") &&
+		pointer.StringDeref(desired.BucketID, "") == pointer.StringDeref(obs.BucketID, "")
+}
+
+// scraperTargetType returns the given type with the default of the API filled
+// in.
+func scraperTargetType(t *domain.ScraperTargetRequestType) domain.ScraperTargetRequestType {
+	if t == nil {
+		return domain.ScraperTargetRequestTypePrometheus
+	}
+	return *t
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotSetup           = "managed resource is not a Setup custom resource"
+	errGetOnboarding      = "cannot get onboarding status"
+	errOnboard            = "cannot onboard the instance"
+	errGetPasswordSecret  = "cannot get password secret"
+	errPasswordKeyMissing = "password key is not found in the referenced secret"
+)
+
+// Setup adds a controller that reconciles Setup managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SetupGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SetupGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Setup{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewOnboardingClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewSetupAPI(cl.HTTPService())}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.SetupAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Setup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSetup)
+	}
+
+	// The initial setup cannot be undone, it's gone as far as we're
+	// concerned.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	onboarded, err := c.api.IsOnboarded(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetOnboarding)
+	}
+	cr.Status.AtProvider.Onboarded = onboarded
+	if !onboarded {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(v1.Available())
+	// None of the fields can be changed after the initial setup.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Setup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSetup)
+	}
+
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pw, ok := s.Data[ref.Key]
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errPasswordKeyMissing)
+	}
+
+	resp, err := c.api.Onboard(ctx, GenerateOnboardingRequest(cr.Spec.ForProvider, string(pw)))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errOnboard)
+	}
+	return managed.ExternalCreation{ConnectionDetails: GetConnectionDetails(resp)}, nil
+}
+
+func (c *external) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, ok := mg.(*v1alpha1.Setup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSetup)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(_ context.Context, mg resource.Managed) error {
+	_, ok := mg.(*v1alpha1.Setup)
+	if !ok {
+		return errors.New(errNotSetup)
+	}
+	// The initial setup cannot be undone. The organization, bucket and user
+	// it created are left as they are. Observe reports it as gone once the
+	// resource is deleted, so this is not called.
+	return nil
+}
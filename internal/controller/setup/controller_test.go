@@ -0,0 +1,471 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func mockSecretGet(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.SetupAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotSetup": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotSetup),
+			},
+		},
+		"Deleted": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "influxdb",
+						DeletionTimestamp: &now,
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "influxdb",
+						DeletionTimestamp: &now,
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				api: &clients.MockSetupAPI{
+					IsOnboardedFn: func(_ context.Context) (bool, error) {
+						return false, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetOnboarding),
+			},
+		},
+		"NotOnboarded": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				api: &clients.MockSetupAPI{
+					IsOnboardedFn: func(_ context.Context) (bool, error) {
+						return false, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+					Status: v1alpha1.SetupStatus{
+						AtProvider: v1alpha1.SetupObservation{
+							Onboarded: false,
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Onboarded": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				api: &clients.MockSetupAPI{
+					IsOnboardedFn: func(_ context.Context) (bool, error) {
+						return true, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+					Status: v1alpha1.SetupStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.SetupObservation{
+							Onboarded: true,
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.SetupAPI
+	}
+	type want struct {
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotSetup": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotSetup),
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPasswordSecret),
+			},
+		},
+		"PasswordKeyMissing": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet(map[string][]byte{"other": []byte("s3cr3t")}),
+				},
+			},
+			want: want{
+				err: errors.New(errPasswordKeyMissing),
+			},
+		},
+		"OnboardFailed": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet(map[string][]byte{"password": []byte("s3cr3t")}),
+				},
+				api: &clients.MockSetupAPI{
+					OnboardFn: func(_ context.Context, _ *domain.OnboardingRequest) (*domain.OnboardingResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errOnboard),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Setup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "influxdb",
+					},
+					Spec: v1alpha1.SetupSpec{
+						ForProvider: v1alpha1.SetupParameters{
+							Organization: "acme",
+							Bucket:       "metrics",
+							Username:     "admin",
+							PasswordSecretRef: xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "admin", Namespace: "crossplane-system"},
+								Key:             "password",
+							},
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: mockSecretGet(map[string][]byte{"password": []byte("s3cr3t")}),
+				},
+				api: &clients.MockSetupAPI{
+					OnboardFn: func(_ context.Context, req *domain.OnboardingRequest) (*domain.OnboardingResponse, error) {
+						want := &domain.OnboardingRequest{
+							Org:                    "acme",
+							Bucket:                 "metrics",
+							Username:               "admin",
+							Password:               pointer.String("s3cr3t"),
+							RetentionPeriodSeconds: pointer.Int64(3600),
+						}
+						if diff := cmp.Diff(want, req); diff != "" {
+							t.Errorf("Onboard(...): -want, +got:\n%s", diff)
+						}
+						resp := &domain.OnboardingResponse{
+							Auth:   &domain.Authorization{Token: pointer.String("token")},
+							Org:    &domain.Organization{Id: pointer.String("org")},
+							Bucket: &domain.Bucket{Id: pointer.String("bucket")},
+							User:   &domain.UserResponse{Id: pointer.String("user")},
+						}
+						return resp, nil
+					},
+				},
+			},
+			want: want{
+				cre: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						keyToken:    []byte("token"),
+						keyOrgID:    []byte("org"),
+						keyBucketID: []byte("bucket"),
+						keyUserID:   []byte("user"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
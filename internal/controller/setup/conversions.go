@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	keyToken    = "token"
+	keyOrgID    = "orgID"
+	keyBucketID = "bucketID"
+	keyUserID   = "userID"
+)
+
+// GenerateOnboardingRequest returns an OnboardingRequest that the InfluxDB API
+// accepts for the initial setup.
+func GenerateOnboardingRequest(params v1alpha1.SetupParameters, password string) *domain.OnboardingRequest {
+	return &domain.OnboardingRequest{
+		Org:                    params.Organization,
+		Bucket:                 params.Bucket,
+		Username:               params.Username,
+		Password:               pointer.String(password),
+		RetentionPeriodSeconds: params.RetentionPeriodSeconds,
+	}
+}
+
+// GetConnectionDetails returns the operator token and the IDs of the resources
+// created during the initial setup.
+func GetConnectionDetails(resp *domain.OnboardingResponse) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if resp.Auth != nil && resp.Auth.Token != nil {
+		cd[keyToken] = []byte(*resp.Auth.Token)
+	}
+	if resp.Org != nil && resp.Org.Id != nil {
+		cd[keyOrgID] = []byte(*resp.Org.Id)
+	}
+	if resp.Bucket != nil && resp.Bucket.Id != nil {
+		cd[keyBucketID] = []byte(*resp.Bucket.Id)
+	}
+	if resp.User != nil && resp.User.Id != nil {
+		cd[keyUserID] = []byte(*resp.User.Id)
+	}
+	return cd
+}
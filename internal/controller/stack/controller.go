@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotStack           = "managed resource is not a Stack custom resource"
+	errGetStack           = "cannot get stack"
+	errCreateStack        = "cannot create stack"
+	errUpdateStack        = "cannot update stack"
+	errUninstallStack     = "cannot uninstall stack"
+	errDeleteStack        = "cannot delete stack"
+	errDryRunTemplate     = "cannot dry run template"
+	errApplyTemplate      = "cannot apply template"
+	errNoTemplate         = "one of template, templateConfigMapRef or templateURL has to be given"
+	errGetConfigMap       = "cannot get ConfigMap with the template"
+	errTemplateKeyMissing = "referenced key does not exist in the ConfigMap"
+	errFetchTemplate      = "cannot fetch template from URL"
+
+	templateFetchTimeout = 30 * time.Second
+)
+
+// Setup adds a controller that reconciles Stack managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.StackGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.StackGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			templates: clients.NewTemplateFetcher(&http.Client{Timeout: templateFetchTimeout}),
+		}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Stack{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube      client.Client
+	templates clients.TemplateFetcher
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	rc, err := clients.NewClientWithResponses(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewStacksAPI(rc), templates: c.templates}, nil
+}
+
+type external struct {
+	kube      client.Client
+	api       clients.StacksAPI
+	templates clients.TemplateFetcher
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotStack)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	s, err := c.api.ReadStack(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetStack)
+	}
+	// The template is not fetched when only the deletion is left since its
+	// ConfigMap or URL is often gone together with the resource.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	req, err := c.generateApplyRequest(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = GenerateStackObservation(s, cr.Status.AtProvider)
+	cr.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: IsUpToDate(stackName(cr), HashTemplateApplyRequest(req), cr.Spec.ForProvider, s, cr.Status.AtProvider),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotStack)
+	}
+
+	// The template is installed in the updates that follow so that the
+	// result of the dry run is stored in status before it is applied.
+	s, err := c.api.CreateStack(ctx, GenerateCreateStackRequest(stackName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateStack)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(s.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotStack)
+	}
+	req, err := c.generateApplyRequest(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	req.StackID = meta.GetExternalName(cr)
+
+	if _, err := c.api.UpdateStack(ctx, req.StackID, GenerateUpdateStackRequest(stackName(cr), cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateStack)
+	}
+
+	// A changed template is only dry run first. The diff is persisted in
+	// status when this update returns and the template is applied in the
+	// next one, so the diff tells what the template is about to change even
+	// if applying fails.
+	hash := HashTemplateApplyRequest(req)
+	if hash != cr.Status.AtProvider.DiffHash {
+		req.DryRun = true
+		sum, err := c.api.ApplyTemplate(ctx, req)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDryRunTemplate)
+		}
+		cr.Status.AtProvider.Diff = GenerateStackDiff(sum)
+		cr.Status.AtProvider.DiffHash = hash
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if _, err := c.api.ApplyTemplate(ctx, req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errApplyTemplate)
+	}
+	cr.Status.AtProvider.TemplateHash = hash
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return errors.New(errNotStack)
+	}
+	// Uninstalling removes all resources that the stack owns.
+	if err := c.api.UninstallStack(ctx, meta.GetExternalName(cr)); resource.Ignore(clients.IsNotFound, err) != nil {
+		return errors.Wrap(err, errUninstallStack)
+	}
+	err := c.api.DeleteStack(ctx, meta.GetExternalName(cr), pointer.StringDeref(cr.Spec.ForProvider.OrgID, ""))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteStack)
+}
+
+// generateApplyRequest returns the request that applies the template given
+// either inline, in the referenced ConfigMap or by URL.
+func (c *external) generateApplyRequest(ctx context.Context, params v1alpha1.StackParameters) (clients.TemplateApplyRequest, error) {
+	var s string
+	switch {
+	case params.Template != nil:
+		s = *params.Template
+	case params.TemplateConfigMapRef != nil:
+		ref := params.TemplateConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return clients.TemplateApplyRequest{}, errors.Wrap(err, errGetConfigMap)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return clients.TemplateApplyRequest{}, errors.New(errTemplateKeyMissing)
+		}
+		s = v
+	case params.TemplateURL != nil:
+		// The template is fetched by the provider so that changes behind the
+		// URL are detected.
+		v, err := c.templates.FetchTemplate(ctx, *params.TemplateURL)
+		if err != nil {
+			return clients.TemplateApplyRequest{}, errors.Wrap(err, errFetchTemplate)
+		}
+		s = v
+	default:
+		return clients.TemplateApplyRequest{}, errors.New(errNoTemplate)
+	}
+	template, err := ParseTemplate(s)
+	if err != nil {
+		return clients.TemplateApplyRequest{}, err
+	}
+	return GenerateTemplateApplyRequest(template, params), nil
+}
+
+// stackName returns the name of the stack in InfluxDB.
+func stackName(cr *v1alpha1.Stack) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,1298 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const (
+	templateYAML = `apiVersion: influxdata.com/v2alpha1
+kind: Bucket
+metadata:
+  name: metrics
+spec:
+  name: metrics
+---
+apiVersion: influxdata.com/v2alpha1
+kind: Label
+metadata:
+  name: team
+spec:
+  name: team
+`
+	templateJSON = `[
+  {"apiVersion": "influxdata.com/v2alpha1", "kind": "Bucket", "metadata": {"name": "metrics"}, "spec": {"name": "metrics"}},
+  {"apiVersion": "influxdata.com/v2alpha1", "kind": "Label", "metadata": {"name": "team"}, "spec": {"name": "team"}}
+]`
+)
+
+// observed returns a stack whose latest event has the given template URL and
+// a single bucket.
+func observed(t *testing.T, url string) *domain.Stack {
+	t.Helper()
+	urls := "[]"
+	if url != "" {
+		urls = fmt.Sprintf("[%q]", url)
+	}
+	s := &domain.Stack{}
+	if err := json.Unmarshal([]byte(fmt.Sprintf(`{
+  "id": "id",
+  "events": [
+    {"eventType": "create", "name": "monitoring", "urls": []},
+    {"eventType": "update", "name": "monitoring", "urls": %s,
+     "resources": [{"kind": "Bucket", "resourceID": "bucket", "templateMetaName": "metrics"}]}
+  ]
+}`, urls)), s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// hash returns the hash of the given inline template that is applied to the
+// org of the test stack.
+func hash(t *testing.T, template string) string {
+	t.Helper()
+	c, err := ParseTemplate(template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return HashTemplateApplyRequest(GenerateTemplateApplyRequest(c, v1alpha1.StackParameters{OrgID: pointer.String("org")}))
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg        resource.Managed
+		kube      client.Client
+		api       clients.StacksAPI
+		templates clients.TemplateFetcher
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	resources := []v1alpha1.StackResource{{Kind: "Bucket", ResourceID: "bucket", TemplateMetaName: "metrics"}}
+	readStack := func(url string) *clients.MockStacksAPI {
+		return &clients.MockStacksAPI{
+			ReadStackFn: func(_ context.Context, _ string) (*domain.Stack, error) {
+				return observed(t, url), nil
+			},
+		}
+	}
+	fetch := func(template string) *clients.MockTemplateFetcher {
+		return &clients.MockTemplateFetcher{
+			FetchTemplateFn: func(_ context.Context, _ string) (string, error) {
+				return template, nil
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotStack": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotStack),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					ReadStackFn: func(_ context.Context, _ string) (*domain.Stack, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetStack),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					ReadStackFn: func(_ context.Context, _ string) (*domain.Stack, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoTemplate": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: readStack(""),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				err: errors.New(errNoTemplate),
+			},
+		},
+		"TemplateKeyMissing": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:                pointer.String("org"),
+							TemplateConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "template.yml"},
+						},
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				api:  readStack(""),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:                pointer.String("org"),
+							TemplateConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "template.yml"},
+						},
+					},
+				},
+				err: errors.New(errTemplateKeyMissing),
+			},
+		},
+		"DeletedWithTemplateURL": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "monitoring",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("https://example.com/template.yml"),
+						},
+					},
+				},
+				api: readStack(""),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "monitoring",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("https://example.com/template.yml"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{TemplateHash: hash(t, templateJSON)},
+					},
+				},
+				api: readStack(""),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.StackObservation{
+							ID:           "id",
+							Resources:    resources,
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TemplateInConfigMapChanged": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:                pointer.String("org"),
+							TemplateConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "template.yml"},
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{TemplateHash: hash(t, templateJSON)},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"template.yml": "kind: Label\nmetadata:\n  name: other\n"}
+						return nil
+					},
+				},
+				api: readStack(""),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:                pointer.String("org"),
+							TemplateConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "template.yml"},
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.StackObservation{
+							ID:           "id",
+							Resources:    resources,
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FetchTemplateFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+				},
+				api: readStack(""),
+				templates: &clients.MockTemplateFetcher{
+					FetchTemplateFn: func(_ context.Context, _ string) (string, error) {
+						return "", errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errFetchTemplate),
+			},
+		},
+		"TemplateBehindURLUpToDate": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{TemplateHash: hash(t, templateJSON)},
+					},
+				},
+				api:       readStack(""),
+				templates: fetch(templateYAML),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.StackObservation{
+							ID:           "id",
+							Resources:    resources,
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TemplateBehindURLChanged": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{TemplateHash: hash(t, templateJSON)},
+					},
+				},
+				api:       readStack(""),
+				templates: fetch("kind: Label\nmetadata:\n  name: other\n"),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.StackObservation{
+							ID:           "id",
+							Resources:    resources,
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"StackHasTemplateURLs": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{TemplateHash: hash(t, templateJSON)},
+					},
+				},
+				api: readStack("http://templates/old.yml"),
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.StackObservation{
+							ID:           "id",
+							Resources:    resources,
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api, templates: tc.args.templates}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.StacksAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotStack": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotStack),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					CreateStackFn: func(_ context.Context, _ domain.CreateStackJSONBody) (*domain.Stack, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateStack),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					CreateStackFn: func(_ context.Context, s domain.CreateStackJSONBody) (*domain.Stack, error) {
+						if pointer.StringDeref(s.Name, "") != "monitoring" || pointer.StringDeref(s.OrgID, "") != "org" {
+							t.Errorf("creation call has to use the name and the org of the stack")
+						}
+						if s.Urls == nil || len(*s.Urls) != 0 {
+							t.Errorf("creation call must not register template URLs that InfluxDB would apply again")
+						}
+						return &domain.Stack{Id: pointer.String("id")}, nil
+					},
+					ApplyTemplateFn: func(_ context.Context, _ clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						t.Errorf("template must not be applied during creation")
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:       pointer.String("org"),
+							TemplateURL: pointer.String("http://templates/t.yml"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.StacksAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	summary := &clients.TemplateSummary{
+		StackID: "id",
+		Diff: map[string][]clients.TemplateDiffEntry{
+			"labels":        {{Kind: "Label", TemplateMetaName: "team", StateStatus: "new"}},
+			"buckets":       {{Kind: "Bucket", TemplateMetaName: "metrics", StateStatus: "exists"}},
+			"labelMappings": {{}},
+		},
+	}
+	diff := []v1alpha1.StackDiffEntry{
+		{Kind: "Bucket", TemplateMetaName: "metrics", StateStatus: "exists"},
+		{Kind: "Label", TemplateMetaName: "team", StateStatus: "new"},
+	}
+	updateStack := func(_ context.Context, _ string, _ domain.UpdateStackJSONBody) (*domain.Stack, error) {
+		return &domain.Stack{}, nil
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotStack": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotStack),
+			},
+		},
+		"UpdateStackFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: func(_ context.Context, _ string, _ domain.UpdateStackJSONBody) (*domain.Stack, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errUpdateStack),
+			},
+		},
+		"DryRunFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: updateStack,
+					ApplyTemplateFn: func(_ context.Context, _ clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errDryRunTemplate),
+			},
+		},
+		"ApplyFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{Diff: diff, DiffHash: hash(t, templateJSON)},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: updateStack,
+					ApplyTemplateFn: func(_ context.Context, _ clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{Diff: diff, DiffHash: hash(t, templateJSON)},
+					},
+				},
+				err: errors.Wrap(errBoom, errApplyTemplate),
+			},
+		},
+		"DryRun": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: func(_ context.Context, id string, s domain.UpdateStackJSONBody) (*domain.Stack, error) {
+						if id != "id" || pointer.StringDeref(s.Name, "") != "monitoring" {
+							t.Errorf("update call has to use the ID and the name of the stack")
+						}
+						return &domain.Stack{}, nil
+					},
+					ApplyTemplateFn: func(_ context.Context, req clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						if req.StackID != "id" || req.OrgID != "org" || req.Template == nil {
+							t.Errorf("apply call has to include the stack, the org and the template")
+						}
+						if !req.DryRun {
+							t.Errorf("template has to be dry run before its diff is stored")
+						}
+						return summary, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{
+							Diff:     diff,
+							DiffHash: hash(t, templateJSON),
+						},
+					},
+				},
+			},
+		},
+		"DryRunOfChangedTemplate": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{DiffHash: "old", TemplateHash: "old"},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: updateStack,
+					ApplyTemplateFn: func(_ context.Context, req clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						if !req.DryRun {
+							t.Errorf("changed template has to be dry run before its diff is stored")
+						}
+						return summary, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{
+							Diff:         diff,
+							DiffHash:     hash(t, templateJSON),
+							TemplateHash: "old",
+						},
+					},
+				},
+			},
+		},
+		"Apply": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{Diff: diff, DiffHash: hash(t, templateJSON)},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UpdateStackFn: updateStack,
+					ApplyTemplateFn: func(_ context.Context, req clients.TemplateApplyRequest) (*clients.TemplateSummary, error) {
+						if req.DryRun {
+							t.Errorf("template with a stored diff has to be applied")
+						}
+						return summary, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID:    pointer.String("org"),
+							Template: pointer.String(templateYAML),
+						},
+					},
+					Status: v1alpha1.StackStatus{
+						AtProvider: v1alpha1.StackObservation{
+							Diff:         diff,
+							DiffHash:     hash(t, templateJSON),
+							TemplateHash: hash(t, templateJSON),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.StacksAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotStack": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotStack),
+			},
+		},
+		"UninstallFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UninstallStackFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUninstallStack),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UninstallStackFn: func(_ context.Context, _ string) error {
+						return nil
+					},
+					DeleteStackFn: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteStack),
+			},
+		},
+		"UninstallAndDelete": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UninstallStackFn: func(_ context.Context, id string) error {
+						if id != "id" {
+							t.Errorf("uninstall call has to use the ID of the stack")
+						}
+						return nil
+					},
+					DeleteStackFn: func(_ context.Context, id, orgID string) error {
+						if id != "id" || orgID != "org" {
+							t.Errorf("deletion call has to use the ID and the org of the stack")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mg: &v1alpha1.Stack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "monitoring",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.StackSpec{
+						ForProvider: v1alpha1.StackParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockStacksAPI{
+					UninstallStackFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+					DeleteStackFn: func(_ context.Context, _, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
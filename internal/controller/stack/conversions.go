@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errDecodeTemplate = "cannot decode template"
+	errEmptyTemplate  = "template does not contain any resources"
+
+	contentTypeJSON = "json"
+)
+
+// ParseTemplate converts the given template in YAML or JSON format to the JSON
+// list of resources that the InfluxDB API accepts. YAML templates may consist
+// of multiple documents.
+func ParseTemplate(s string) (json.RawMessage, error) {
+	var resources []interface{}
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(s), 4096)
+	for {
+		var doc interface{}
+		err := d.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errDecodeTemplate)
+		}
+		switch v := doc.(type) {
+		case nil:
+		case []interface{}:
+			resources = append(resources, v...)
+		default:
+			resources = append(resources, v)
+		}
+	}
+	if len(resources) == 0 {
+		return nil, errors.New(errEmptyTemplate)
+	}
+	b, err := json.Marshal(resources)
+	return b, errors.Wrap(err, errDecodeTemplate)
+}
+
+// GenerateTemplateApplyRequest returns the request that applies the given
+// template. Templates given by URL are fetched by the provider and sent the
+// same way as the other ones.
+func GenerateTemplateApplyRequest(template json.RawMessage, params v1alpha1.StackParameters) clients.TemplateApplyRequest {
+	return clients.TemplateApplyRequest{
+		OrgID:   pointer.StringDeref(params.OrgID, ""),
+		EnvRefs: params.EnvRefs,
+		Template: &clients.TemplateContents{
+			ContentType: contentTypeJSON,
+			Contents:    template,
+		},
+	}
+}
+
+// HashTemplateApplyRequest returns a hash of what the given request applies,
+// regardless of the stack it applies to and whether it is a dry run.
+func HashTemplateApplyRequest(req clients.TemplateApplyRequest) string {
+	req.DryRun = false
+	req.StackID = ""
+	// Marshalling cannot fail since the contents are valid JSON.
+	b, _ := json.Marshal(req)
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// GenerateCreateStackRequest returns a request that the InfluxDB API accepts
+// for creation of a stack. The stack has no template URLs since InfluxDB would
+// apply them in addition to the template that is sent.
+func GenerateCreateStackRequest(name string, params v1alpha1.StackParameters) domain.CreateStackJSONBody {
+	return domain.CreateStackJSONBody{
+		Name:        pointer.String(name),
+		Description: params.Description,
+		OrgID:       params.OrgID,
+		Urls:        &[]string{},
+	}
+}
+
+// GenerateUpdateStackRequest returns a request that the InfluxDB API accepts
+// for update of a stack.
+func GenerateUpdateStackRequest(name string, params v1alpha1.StackParameters) domain.UpdateStackJSONBody {
+	return domain.UpdateStackJSONBody{
+		Name:         pointer.String(name),
+		Description:  pointer.String(pointer.StringDeref(params.Description, "")),
+		TemplateURLs: &[]string{},
+	}
+}
+
+// GenerateStackObservation converts a Stack response to an observation. The
+// resources and the last update come from the latest event of the stack. The
+// dry run diff and the template hashes are not part of the response, so they are
+// taken from the given previous observation.
+func GenerateStackObservation(s *domain.Stack, prev v1alpha1.StackObservation) v1alpha1.StackObservation {
+	o := v1alpha1.StackObservation{
+		ID:           pointer.StringDeref(s.Id, ""),
+		Diff:         prev.Diff,
+		DiffHash:     prev.DiffHash,
+		TemplateHash: prev.TemplateHash,
+	}
+	if s.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*s.CreatedAt)
+	}
+	if s.Events == nil || len(*s.Events) == 0 {
+		return o
+	}
+	e := (*s.Events)[len(*s.Events)-1]
+	if e.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*e.UpdatedAt)
+	}
+	if e.Resources != nil && len(*e.Resources) != 0 {
+		o.Resources = make([]v1alpha1.StackResource, len(*e.Resources))
+		for i, r := range *e.Resources {
+			o.Resources[i] = v1alpha1.StackResource{
+				TemplateMetaName: pointer.StringDeref(r.TemplateMetaName, ""),
+				ResourceID:       pointer.StringDeref(r.ResourceID, ""),
+			}
+			if r.Kind != nil {
+				o.Resources[i].Kind = string(*r.Kind)
+			}
+		}
+	}
+	return o
+}
+
+// GenerateStackDiff converts the diff of a dry run to the one that is stored in
+// status. Label mappings are left out since they do not have a kind.
+func GenerateStackDiff(s *clients.TemplateSummary) []v1alpha1.StackDiffEntry {
+	var out []v1alpha1.StackDiffEntry
+	for _, entries := range s.Diff {
+		for _, e := range entries {
+			if e.Kind == "" {
+				continue
+			}
+			out = append(out, v1alpha1.StackDiffEntry{
+				Kind:             e.Kind,
+				TemplateMetaName: e.TemplateMetaName,
+				StateStatus:      e.StateStatus,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].TemplateMetaName < out[j].TemplateMetaName
+	})
+	return out
+}
+
+// IsUpToDate returns whether an update call is necessary. The template is up
+// to date if its hash matches the one of the template applied last.
+func IsUpToDate(name, hash string, params v1alpha1.StackParameters, obs *domain.Stack, applied v1alpha1.StackObservation) bool {
+	if hash != applied.TemplateHash || obs.Events == nil || len(*obs.Events) == 0 {
+		return false
+	}
+	e := (*obs.Events)[len(*obs.Events)-1]
+	return name == pointer.StringDeref(e.Name, "") &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(e.Description, "") &&
+		(e.Urls == nil || len(*e.Urls) == 0)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotTask        = "managed resource is not a Task custom resource"
+	errGetTask        = "cannot get task"
+	errCreateTask     = "cannot create task"
+	errNoCreatedTask  = "created task is missing in the response"
+	errUpdateTask     = "cannot update task"
+	errDeleteTask     = "cannot delete task"
+	errNoFlux         = "either flux or fluxConfigMapRef has to be given"
+	errGetConfigMap   = "cannot get ConfigMap with the flux script"
+	errFluxKeyMissing = "referenced key does not exist in the ConfigMap"
+	errAnalyzeFlux    = "cannot analyze flux script"
+	errInvalidFlux    = "flux script is invalid"
+)
+
+// Setup adds a controller that reconciles Task managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TaskGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TaskGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Task{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: cs.Client.TasksAPI(), create: cs.WithResponses, flux: cs.WithResponses}, nil
+}
+
+type external struct {
+	kube   client.Client
+	api    clients.TasksAPI
+	create clients.TaskCreateAPI
+	flux   clients.FluxAnalyzeAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTask)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	task, err := c.api.GetTaskByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetTask)
+	}
+	// The script is not read when only the deletion is left since its
+	// ConfigMap is often gone together with the resource.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	script, err := c.getScript(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = GenerateTaskObservation(task)
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.TaskStatusTypeActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.TaskStatusTypeInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+	li := LateInitialize(&cr.Spec.ForProvider, task)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        IsUpToDate(taskName(cr), script, cr.Spec.ForProvider, task),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTask)
+	}
+	script, err := c.getScript(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	flux := clients.GenerateTaskFlux(GenerateTaskOptions(taskName(cr), cr.Spec.ForProvider), script)
+	if err := c.analyze(ctx, flux); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	resp, err := c.create.PostTasksWithResponse(ctx, &domain.PostTasksParams{}, domain.PostTasksJSONRequestBody(GenerateTaskCreateRequest(flux, cr.Spec.ForProvider)))
+	if err == nil && resp.JSONDefault != nil {
+		err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTask)
+	}
+	if resp.JSON201 == nil {
+		return managed.ExternalCreation{}, errors.New(errNoCreatedTask)
+	}
+	meta.SetExternalName(cr, resp.JSON201.Id)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTask)
+	}
+	script, err := c.getScript(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	flux := clients.GenerateTaskFlux(GenerateTaskOptions(taskName(cr), cr.Spec.ForProvider), script)
+	if err := c.analyze(ctx, flux); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	t := GenerateTask(taskName(cr), flux, cr.Spec.ForProvider)
+	t.Id = meta.GetExternalName(cr)
+	_, err = c.api.UpdateTask(ctx, t)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTask)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return errors.New(errNotTask)
+	}
+	err := c.api.DeleteTaskWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteTask)
+}
+
+// getScript returns the Flux script given either inline or in the referenced
+// ConfigMap.
+func (c *external) getScript(ctx context.Context, params v1alpha1.TaskParameters) (string, error) {
+	switch {
+	case params.Flux != nil:
+		return *params.Flux, nil
+	case params.FluxConfigMapRef != nil:
+		ref := params.FluxConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		s, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.New(errFluxKeyMissing)
+		}
+		return s, nil
+	}
+	return "", errors.New(errNoFlux)
+}
+
+// analyze returns an error if the given Flux script is not valid.
+func (c *external) analyze(ctx context.Context, flux string) error {
+	t := domain.QueryTypeFlux
+	resp, err := c.flux.PostQueryAnalyzeWithResponse(ctx, &domain.PostQueryAnalyzeParams{}, domain.PostQueryAnalyzeJSONRequestBody{Query: flux, Type: &t})
+	if err == nil && resp.JSONDefault != nil {
+		err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	if err != nil {
+		return errors.Wrap(err, errAnalyzeFlux)
+	}
+	return GetAnalyzeError(resp.JSON200)
+}
+
+// taskName returns the name of the task in InfluxDB.
+func taskName(cr *v1alpha1.Task) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
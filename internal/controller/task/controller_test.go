@@ -0,0 +1,845 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const script = `from(bucket: "b") |> range(start: -task.every)`
+
+func validFlux() clients.FluxAnalyzeAPI {
+	return &clients.MockFluxAnalyzeAPI{
+		PostQueryAnalyzeWithResponseFn: func(_ context.Context, _ *domain.PostQueryAnalyzeParams, _ domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error) {
+			return &domain.PostQueryAnalyzeResponse{JSON200: &domain.AnalyzeQueryResponse{}}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	active := domain.TaskStatusTypeActive
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.TasksAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTask": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTask),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetTask),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ConfigMapFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:            pointer.String("org"),
+							FluxConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "flux"},
+							Every:            pointer.String("1h"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{Id: "id"}, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+		"DeletedConfigMapMissing": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "downsample",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:            pointer.String("org"),
+							FluxConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "flux"},
+							Every:            pointer.String("1h"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("configmaps"), "cm")),
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{Id: "id"}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:  pointer.String("org"),
+							Flux:   pointer.String(script),
+							Every:  pointer.String("1h"),
+							Offset: pointer.String("0s"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{
+							Id:     "id",
+							Name:   "downsample",
+							Flux:   "option task = { name: \"downsample\", every: 1h } \n" + script,
+							Every:  pointer.String("1h"),
+							Offset: pointer.String("0s"),
+							Status: &active,
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ScriptChangedInConfigMap": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:            pointer.String("org"),
+							FluxConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "flux"},
+							Every:            pointer.String("1h"),
+							Offset:           pointer.String("0s"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"flux": "new script"}
+						return nil
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{
+							Id:     "id",
+							Name:   "downsample",
+							Flux:   "option task = {name: \"downsample\", every: 1h}\n\n" + script,
+							Every:  pointer.String("1h"),
+							Offset: pointer.String("0s"),
+							Status: &active,
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg     resource.Managed
+		create clients.TaskCreateAPI
+		flux   clients.FluxAnalyzeAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTask": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTask),
+			},
+		},
+		"NoFlux": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				err: errors.New(errNoFlux),
+			},
+		},
+		"InvalidFlux": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String("from("),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: &clients.MockFluxAnalyzeAPI{
+					PostQueryAnalyzeWithResponseFn: func(_ context.Context, _ *domain.PostQueryAnalyzeParams, _ domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error) {
+						return &domain.PostQueryAnalyzeResponse{JSON200: &domain.AnalyzeQueryResponse{
+							Errors: &[]struct {
+								Character *int    `json:"character,omitempty"`
+								Column    *int    `json:"column,omitempty"`
+								Line      *int    `json:"line,omitempty"`
+								Message   *string `json:"message,omitempty"`
+							}{{Line: pointer.Int(3), Column: pointer.Int(6), Message: pointer.String("expected RPAREN")}},
+						}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String("from("),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				err: errors.New(errInvalidFlux + ": line 1, column 6: expected RPAREN"),
+			},
+		},
+		"AnalyzeFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: &clients.MockFluxAnalyzeAPI{
+					PostQueryAnalyzeWithResponseFn: func(_ context.Context, _ *domain.PostQueryAnalyzeParams, _ domain.PostQueryAnalyzeJSONRequestBody) (*domain.PostQueryAnalyzeResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errAnalyzeFlux),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: validFlux(),
+				create: &clients.MockTaskCreateAPI{
+					PostTasksWithResponseFn: func(_ context.Context, _ *domain.PostTasksParams, _ domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateTask),
+			},
+		},
+		"NoCreatedTask": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: validFlux(),
+				create: &clients.MockTaskCreateAPI{
+					PostTasksWithResponseFn: func(_ context.Context, _ *domain.PostTasksParams, _ domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error) {
+						return &domain.PostTasksResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				err: errors.New(errNoCreatedTask),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: validFlux(),
+				create: &clients.MockTaskCreateAPI{
+					PostTasksWithResponseFn: func(_ context.Context, _ *domain.PostTasksParams, body domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error) {
+						if body.Flux != "option task = {name: \"downsample\", every: 1h}\n\n"+script || pointer.StringDeref(body.OrgID, "") != "org" {
+							t.Errorf("creation call has to include task options, script and org id")
+						}
+						return &domain.PostTasksResponse{JSON201: &domain.Task{Id: "id"}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+			},
+		},
+		"SuccessWithOffset": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:  pointer.String("org"),
+							Flux:   pointer.String(script),
+							Every:  pointer.String("1h"),
+							Offset: pointer.String("5m"),
+						},
+					},
+				},
+				flux: validFlux(),
+				create: &clients.MockTaskCreateAPI{
+					PostTasksWithResponseFn: func(_ context.Context, _ *domain.PostTasksParams, body domain.PostTasksJSONRequestBody) (*domain.PostTasksResponse, error) {
+						if !strings.HasPrefix(body.Flux, "option task = {name: \"downsample\", every: 1h, offset: 5m}") {
+							t.Errorf("creation call has to include the offset in the task options")
+						}
+						return &domain.PostTasksResponse{JSON201: &domain.Task{Id: "id"}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:  pointer.String("org"),
+							Flux:   pointer.String(script),
+							Every:  pointer.String("1h"),
+							Offset: pointer.String("5m"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{create: tc.args.create, flux: tc.args.flux}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.TasksAPI
+		flux clients.FluxAnalyzeAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTask": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTask),
+			},
+		},
+		"KeyMissing": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:            pointer.String("org"),
+							FluxConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "flux"},
+							Every:            pointer.String("1h"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			want: want{
+				err: errors.New(errFluxKeyMissing),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Flux:  pointer.String(script),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				flux: validFlux(),
+				api: &clients.MockTasksAPI{
+					UpdateTaskFn: func(_ context.Context, _ *domain.Task) (*domain.Task, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateTask),
+			},
+		},
+		"SuccessFromConfigMap": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID:            pointer.String("org"),
+							FluxConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "flux"},
+							Every:            pointer.String("1h"),
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"flux": script}
+						return nil
+					},
+				},
+				flux: validFlux(),
+				api: &clients.MockTasksAPI{
+					UpdateTaskFn: func(_ context.Context, tk *domain.Task) (*domain.Task, error) {
+						if tk.Id != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if clients.StripTaskOptions(tk.Flux) != script {
+							t.Errorf("update call has to include the script from the ConfigMap")
+						}
+						return tk, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api, flux: tc.args.flux}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.TasksAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTask": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTask),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					DeleteTaskWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					DeleteTaskWithIDFn: func(_ context.Context, _ string) error {
+						return &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Task{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downsample",
+					},
+					Spec: v1alpha1.TaskSpec{
+						ForProvider: v1alpha1.TaskParameters{
+							OrgID: pointer.String("org"),
+							Every: pointer.String("1h"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					DeleteTaskWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteTask),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
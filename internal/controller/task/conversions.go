@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateTaskObservation converts a Task response to an observation.
+func GenerateTaskObservation(t *domain.Task) v1alpha1.TaskObservation {
+	o := v1alpha1.TaskObservation{
+		ID:              t.Id,
+		Org:             pointer.StringDeref(t.Org, ""),
+		OwnerID:         pointer.StringDeref(t.OwnerID, ""),
+		AuthorizationID: pointer.StringDeref(t.AuthorizationID, ""),
+		LastRunError:    pointer.StringDeref(t.LastRunError, ""),
+	}
+	if t.Status != nil {
+		o.Status = string(*t.Status)
+	}
+	if t.LastRunStatus != nil {
+		o.LastRunStatus = string(*t.LastRunStatus)
+	}
+	if t.LatestCompleted != nil {
+		lc := metav1.NewTime(*t.LatestCompleted)
+		o.LatestCompleted = &lc
+	}
+	if t.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*t.CreatedAt)
+	}
+	if t.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*t.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateTask returns a Task model that the InfluxDB API accepts for update.
+func GenerateTask(name, script string, params v1alpha1.TaskParameters) *domain.Task {
+	out := &domain.Task{
+		Name:        name,
+		Description: params.Description,
+		Flux:        script,
+		Every:       params.Every,
+		Cron:        params.Cron,
+		Offset:      params.Offset,
+		OrgID:       pointer.StringDeref(params.OrgID, ""),
+	}
+	if params.Status != nil {
+		s := domain.TaskStatusType(*params.Status)
+		out.Status = &s
+	}
+	return out
+}
+
+// GenerateTaskCreateRequest returns a TaskCreateRequest model that the InfluxDB
+// API accepts for creation. The given Flux script has to contain the task
+// option statement.
+func GenerateTaskCreateRequest(flux string, params v1alpha1.TaskParameters) domain.TaskCreateRequest {
+	out := domain.TaskCreateRequest{
+		Description: params.Description,
+		Flux:        flux,
+		OrgID:       params.OrgID,
+	}
+	if params.Status != nil {
+		s := domain.TaskStatusType(*params.Status)
+		out.Status = &s
+	}
+	return out
+}
+
+// GenerateTaskOptions returns the task options for the given parameters.
+func GenerateTaskOptions(name string, params v1alpha1.TaskParameters) clients.TaskOptions {
+	return clients.TaskOptions{
+		Name:   name,
+		Every:  params.Every,
+		Cron:   params.Cron,
+		Offset: params.Offset,
+	}
+}
+
+// GetAnalyzeError returns an error describing the problems that Flux analyze
+// endpoint reported, or nil if there is none. Line numbers are reported
+// relative to the script given by the user.
+func GetAnalyzeError(resp *domain.AnalyzeQueryResponse) error {
+	if resp == nil || resp.Errors == nil || len(*resp.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(*resp.Errors))
+	for i, e := range *resp.Errors {
+		msgs[i] = fmt.Sprintf("line %d, column %d: %s",
+			pointer.IntDeref(e.Line, clients.TaskOptionLines+1)-clients.TaskOptionLines,
+			pointer.IntDeref(e.Column, 0),
+			pointer.StringDeref(e.Message, ""))
+	}
+	return errors.Errorf("%s: %s", errInvalidFlux, strings.Join(msgs, "; "))
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.TaskParameters, obs *domain.Task) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	params.Offset = li.LateInitializeStringPtr(params.Offset, obs.Offset)
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name, script string, params v1alpha1.TaskParameters, obs *domain.Task) bool {
+	status := string(domain.TaskStatusTypeActive)
+	if obs.Status != nil {
+		status = string(*obs.Status)
+	}
+	return obs.Name == name &&
+		clients.StripTaskOptions(obs.Flux) == strings.TrimSpace(script) &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		pointer.StringDeref(params.Every, "") == pointer.StringDeref(obs.Every, "") &&
+		pointer.StringDeref(params.Cron, "") == pointer.StringDeref(obs.Cron, "") &&
+		pointer.StringDeref(params.Offset, "") == pointer.StringDeref(obs.Offset, "") &&
+		pointer.StringDeref(params.Status, string(domain.TaskStatusTypeActive)) == status
+}
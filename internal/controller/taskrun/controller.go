@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskrun
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotTaskRun   = "managed resource is not a TaskRun custom resource"
+	errNoTask       = "either taskID or taskName has to be given"
+	errFindTask     = "cannot find task"
+	errGetTask      = "cannot get task"
+	errTaskNotFound = "task with given name is not found in the organization"
+	errGetRun       = "cannot get run"
+	errGetRunLogs   = "cannot get logs of the run"
+	errStartRun     = "cannot start run"
+	errNoStartedRun = "started run is missing in the response"
+	errCancelRun    = "cannot cancel run"
+)
+
+// Setup adds a controller that reconciles TaskRun managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TaskRunGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TaskRunGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.TaskRun{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{api: cs.Client.TasksAPI(), runs: cs.WithResponses}, nil
+}
+
+type external struct {
+	api  clients.TasksAPI
+	runs clients.TaskRunsAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TaskRun)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTaskRun)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// A completed run cannot change anymore, so it is not fetched again. It
+	// cannot be deleted either, it's gone as far as we're concerned.
+	if !IsRunning(cr.Status.AtProvider.Status) {
+		return managed.ExternalObservation{
+			ResourceExists:   !meta.WasDeleted(cr),
+			ResourceUpToDate: true,
+		}, nil
+	}
+	// The task is looked up by name only until its ID is recorded, since it
+	// could be renamed afterwards.
+	taskID := cr.Status.AtProvider.TaskID
+	if taskID == "" {
+		id, err := c.getTaskID(ctx, cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if id == "" {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		taskID = id
+	}
+
+	run, err := c.api.GetRunByID(ctx, taskID, meta.GetExternalName(cr))
+	if clients.IsNotFound(err) {
+		// The runs of a task are deleted together with it.
+		_, err := c.api.GetTaskByID(ctx, taskID)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetTask)
+		}
+		// InfluxDB drops the history of runs after the retention period of the
+		// _tasks bucket. The run has been started once, so it must not be
+		// started again. The last observed status is kept.
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRun)
+	}
+	obs := GenerateTaskRunObservation(run)
+	switch domain.RunStatus(obs.Status) {
+	case domain.RunStatusSuccess:
+		cr.SetConditions(v1.Available())
+	case domain.RunStatusFailed:
+		logs, err := c.api.FindRunLogsWithID(ctx, taskID, obs.RunID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetRunLogs)
+		}
+		obs.Logs = GenerateLogs(logs)
+		cr.SetConditions(v1.Unavailable())
+	case domain.RunStatusCanceled:
+		cr.SetConditions(v1.Unavailable())
+	default:
+		cr.SetConditions(v1.Creating())
+	}
+	cr.Status.AtProvider = obs
+
+	// A completed run cannot be deleted, it's gone as far as we're concerned.
+	if meta.WasDeleted(cr) && !IsRunning(obs.Status) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// A run cannot be changed once it's started.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TaskRun)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTaskRun)
+	}
+	taskID, err := c.getTaskID(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if taskID == "" {
+		return managed.ExternalCreation{}, errors.New(errTaskNotFound)
+	}
+
+	body := domain.PostTasksIDRunsJSONRequestBody{}
+	if cr.Spec.ForProvider.ScheduledFor != nil {
+		body.ScheduledFor = &cr.Spec.ForProvider.ScheduledFor.Time
+	}
+	resp, err := c.runs.PostTasksIDRunsWithResponse(ctx, taskID, &domain.PostTasksIDRunsParams{}, body)
+	if err == nil && resp.JSONDefault != nil {
+		err = domain.ErrorToHTTPError(resp.JSONDefault, resp.StatusCode())
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStartRun)
+	}
+	if resp.JSON201 == nil {
+		return managed.ExternalCreation{}, errors.New(errNoStartedRun)
+	}
+	meta.SetExternalName(cr, pointer.StringDeref(resp.JSON201.Id, ""))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// A run cannot be changed once it's started.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TaskRun)
+	if !ok {
+		return errors.New(errNotTaskRun)
+	}
+	if !IsRunning(cr.Status.AtProvider.Status) {
+		return nil
+	}
+	taskID := cr.Status.AtProvider.TaskID
+	if taskID == "" {
+		id, err := c.getTaskID(ctx, cr.Spec.ForProvider)
+		if err != nil {
+			return err
+		}
+		taskID = id
+	}
+	// The runs of a task are deleted together with it.
+	if taskID == "" {
+		return nil
+	}
+	err := c.api.CancelRunWithID(ctx, taskID, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errCancelRun)
+}
+
+// getTaskID returns the ID of the task either given directly or found by its
+// name in the given org. It returns an empty ID if no task with the given name
+// is found.
+func (c *external) getTaskID(ctx context.Context, params v1alpha1.TaskRunParameters) (string, error) {
+	switch {
+	case params.TaskID != nil:
+		return *params.TaskID, nil
+	case params.TaskName != nil:
+		tasks, err := c.api.FindTasks(ctx, &api.TaskFilter{
+			Name:  *params.TaskName,
+			OrgID: pointer.StringDeref(params.OrgID, ""),
+		})
+		if err != nil {
+			return "", errors.Wrap(err, errFindTask)
+		}
+		if len(tasks) == 0 {
+			return "", nil
+		}
+		return tasks[0].Id, nil
+	}
+	return "", errors.New(errNoTask)
+}
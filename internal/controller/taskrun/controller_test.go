@@ -0,0 +1,1019 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskrun
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func TestObserve(t *testing.T) {
+	started := domain.RunStatusStarted
+	failed := domain.RunStatusFailed
+	success := domain.RunStatusSuccess
+	type args struct {
+		mg  resource.Managed
+		api clients.TasksAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTaskRun": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTaskRun),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"TaskNotFoundByName": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					FindTasksFn: func(_ context.Context, _ *api.TaskFilter) ([]domain.Task, error) {
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RecordedTaskID": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, taskID, _ string) (*domain.Run, error) {
+						if taskID != "task" {
+							t.Errorf("run has to be fetched with the recorded task id")
+						}
+						return &domain.Run{Id: pointer.String("run"), TaskID: pointer.String("task"), Status: &started}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Creating()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetRunFailed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetRun),
+			},
+		},
+		"Completed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "success"},
+					},
+				},
+				api: &clients.MockTasksAPI{},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "success"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GoneAfterCompletion": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{Id: "task"}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TaskGone": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetTaskFailed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetTask),
+			},
+		},
+		"GoneAndDeleted": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+					GetTaskByIDFn: func(_ context.Context, _ string) (*domain.Task, error) {
+						return &domain.Task{Id: "task"}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Running": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					FindTasksFn: func(_ context.Context, f *api.TaskFilter) ([]domain.Task, error) {
+						if f.Name != "t" || f.OrgID != "org" {
+							t.Errorf("task has to be found by name in the given org")
+						}
+						return []domain.Task{{Id: "task"}}, nil
+					},
+					GetRunByIDFn: func(_ context.Context, taskID, runID string) (*domain.Run, error) {
+						if taskID != "task" || runID != "run" {
+							t.Errorf("run has to be fetched with the task and run ids")
+						}
+						return &domain.Run{Id: pointer.String("run"), TaskID: pointer.String("task"), Status: &started}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Creating()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "started"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Succeeded": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return &domain.Run{Id: pointer.String("run"), TaskID: pointer.String("task"), Status: &success}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "success"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedWithLogs": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return &domain.Run{Id: pointer.String("run"), TaskID: pointer.String("task"), Status: &failed}, nil
+					},
+					FindRunLogsWithIDFn: func(_ context.Context, _, _ string) ([]domain.LogEvent, error) {
+						tm := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+						return []domain.LogEvent{{Message: pointer.String("bucket not found"), Time: &tm}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Unavailable()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{
+							RunID:  "run",
+							TaskID: "task",
+							Status: "failed",
+							Logs:   []string{"2021-10-01T00:00:00Z bucket not found"},
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CompletedAndDeleted": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					GetRunByIDFn: func(_ context.Context, _, _ string) (*domain.Run, error) {
+						return &domain.Run{Id: pointer.String("run"), TaskID: pointer.String("task"), Status: &success}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{xpv1.Available()},
+							},
+						},
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "success"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"CompletedBeforeDeletion": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "failed"},
+					},
+				},
+				api: &clients.MockTasksAPI{},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{RunID: "run", TaskID: "task", Status: "failed"},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	scheduled := metav1.NewTime(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC))
+	type args struct {
+		mg   resource.Managed
+		api  clients.TasksAPI
+		runs clients.TaskRunsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTaskRun": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTaskRun),
+			},
+		},
+		"NoTask": {
+			args: args{
+				mg: &v1alpha1.TaskRun{},
+			},
+			want: want{
+				mg:  &v1alpha1.TaskRun{},
+				err: errors.New(errNoTask),
+			},
+		},
+		"TaskNotFoundByName": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					FindTasksFn: func(_ context.Context, _ *api.TaskFilter) ([]domain.Task, error) {
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				err: errors.New(errTaskNotFound),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				runs: &clients.MockTaskRunsAPI{
+					PostTasksIDRunsWithResponseFn: func(_ context.Context, _ string, _ *domain.PostTasksIDRunsParams, _ domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error) {
+						return &domain.PostTasksIDRunsResponse{
+							HTTPResponse: &http.Response{StatusCode: http.StatusNotFound},
+							JSONDefault:  &domain.Error{Code: domain.ErrorCodeNotFound, Message: "task not found"},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				err: errors.Wrap(&apihttp.Error{StatusCode: http.StatusNotFound, Code: string(domain.ErrorCodeNotFound), Message: "task not found"}, errStartRun),
+			},
+		},
+		"NoStartedRun": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				runs: &clients.MockTaskRunsAPI{
+					PostTasksIDRunsWithResponseFn: func(_ context.Context, _ string, _ *domain.PostTasksIDRunsParams, _ domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error) {
+						return &domain.PostTasksIDRunsResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+				},
+				err: errors.New(errNoStartedRun),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID:       pointer.String("task"),
+							ScheduledFor: &scheduled,
+						},
+					},
+				},
+				runs: &clients.MockTaskRunsAPI{
+					PostTasksIDRunsWithResponseFn: func(_ context.Context, taskID string, _ *domain.PostTasksIDRunsParams, body domain.PostTasksIDRunsJSONRequestBody) (*domain.PostTasksIDRunsResponse, error) {
+						if taskID != "task" {
+							t.Errorf("run has to be started for the given task")
+						}
+						if body.ScheduledFor == nil || !body.ScheduledFor.Equal(scheduled.Time) {
+							t.Errorf("run has to be scheduled for the given time")
+						}
+						return &domain.PostTasksIDRunsResponse{JSON201: &domain.Run{Id: pointer.String("run")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID:       pointer.String("task"),
+							ScheduledFor: &scheduled,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api, runs: tc.args.runs}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.TasksAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTaskRun": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTaskRun),
+			},
+		},
+		"Completed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{Status: "success"},
+					},
+				},
+			},
+		},
+		"CancelRunning": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{TaskID: "task", Status: "started"},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					CancelRunWithIDFn: func(_ context.Context, taskID, runID string) error {
+						if taskID != "task" || runID != "run" {
+							t.Errorf("cancel call has to use the task and run ids")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"CancelWithoutTaskID": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					FindTasksFn: func(_ context.Context, _ *api.TaskFilter) ([]domain.Task, error) {
+						return []domain.Task{{Id: "task"}}, nil
+					},
+					CancelRunWithIDFn: func(_ context.Context, taskID, _ string) error {
+						if taskID != "task" {
+							t.Errorf("cancel call has to use the task id found by name")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"TaskGone": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskName: pointer.String("t"),
+							OrgID:    pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					FindTasksFn: func(_ context.Context, _ *api.TaskFilter) ([]domain.Task, error) {
+						return nil, nil
+					},
+				},
+			},
+		},
+		"CancelFailed": {
+			args: args{
+				mg: &v1alpha1.TaskRun{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "run",
+						},
+					},
+					Spec: v1alpha1.TaskRunSpec{
+						ForProvider: v1alpha1.TaskRunParameters{
+							TaskID: pointer.String("task"),
+						},
+					},
+					Status: v1alpha1.TaskRunStatus{
+						AtProvider: v1alpha1.TaskRunObservation{Status: "scheduled"},
+					},
+				},
+				api: &clients.MockTasksAPI{
+					CancelRunWithIDFn: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCancelRun),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskrun
+
+import (
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// GenerateTaskRunObservation converts a Run response to an observation.
+func GenerateTaskRunObservation(r *domain.Run) v1alpha1.TaskRunObservation {
+	o := v1alpha1.TaskRunObservation{
+		RunID:        pointer.StringDeref(r.Id, ""),
+		TaskID:       pointer.StringDeref(r.TaskID, ""),
+		ScheduledFor: toTime(r.ScheduledFor),
+		RequestedAt:  toTime(r.RequestedAt),
+		StartedAt:    toTime(r.StartedAt),
+		FinishedAt:   toTime(r.FinishedAt),
+	}
+	if r.Status != nil {
+		o.Status = string(*r.Status)
+	}
+	return o
+}
+
+// GenerateLogs returns the messages of the given log events.
+func GenerateLogs(events []domain.LogEvent) []string {
+	if len(events) == 0 {
+		return nil
+	}
+	out := make([]string, len(events))
+	for i, e := range events {
+		out[i] = pointer.StringDeref(e.Message, "")
+		if e.Time != nil {
+			out[i] = e.Time.UTC().Format(time.RFC3339) + " " + out[i]
+		}
+	}
+	return out
+}
+
+// IsRunning returns whether the run with the given status is not completed
+// yet.
+func IsRunning(status string) bool {
+	switch domain.RunStatus(status) {
+	case domain.RunStatusScheduled, domain.RunStatusStarted, "":
+		return true
+	}
+	return false
+}
+
+func toTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telegraf
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotTelegraf      = "managed resource is not a Telegraf custom resource"
+	errGetTelegraf      = "cannot get telegraf configuration"
+	errCreateTelegraf   = "cannot create telegraf configuration"
+	errUpdateTelegraf   = "cannot update telegraf configuration"
+	errDeleteTelegraf   = "cannot delete telegraf configuration"
+	errNoConfig         = "either config or configConfigMapRef has to be given"
+	errGetConfigMap     = "cannot get ConfigMap with the telegraf configuration"
+	errConfigKeyMissing = "referenced key does not exist in the ConfigMap"
+)
+
+// Setup adds a controller that reconciles Telegraf managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TelegrafGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TelegrafGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithInitializers(clients.NewDefaultOrgInitializer(mgr.GetClient())),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Telegraf{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cs, err := clients.NewClientSet(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: clients.NewTelegrafsAPI(cs.WithResponses), serverURL: cs.Client.ServerURL()}, nil
+}
+
+type external struct {
+	kube      client.Client
+	api       clients.TelegrafsAPI
+	serverURL string
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Telegraf)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTelegraf)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	t, err := c.api.GetTelegrafByID(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errGetTelegraf)
+	}
+	// The config is not read when only the deletion is left since its
+	// ConfigMap is often gone together with the resource.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	config, err := c.getConfig(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = GenerateTelegrafObservation(c.serverURL, t)
+	cr.SetConditions(v1.Available())
+	li := LateInitialize(&cr.Spec.ForProvider, t)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        IsUpToDate(telegrafName(cr), config, cr.Spec.ForProvider, t),
+		ConnectionDetails:       GetConnectionDetails(cr.Status.AtProvider.URL),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Telegraf)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTelegraf)
+	}
+	config, err := c.getConfig(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	t, err := c.api.CreateTelegraf(ctx, GenerateTelegrafRequest(telegrafName(cr), config, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTelegraf)
+	}
+	id := pointer.StringDeref(t.Id, "")
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{ConnectionDetails: GetConnectionDetails(GenerateFetchURL(c.serverURL, id))}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Telegraf)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTelegraf)
+	}
+	config, err := c.getConfig(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, err = c.api.UpdateTelegraf(ctx, meta.GetExternalName(cr), GenerateTelegrafRequest(telegrafName(cr), config, cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTelegraf)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Telegraf)
+	if !ok {
+		return errors.New(errNotTelegraf)
+	}
+	err := c.api.DeleteTelegrafWithID(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteTelegraf)
+}
+
+// getConfig returns the TOML configuration given either inline or in the
+// referenced ConfigMap.
+func (c *external) getConfig(ctx context.Context, params v1alpha1.TelegrafParameters) (string, error) {
+	switch {
+	case params.Config != nil:
+		return *params.Config, nil
+	case params.ConfigConfigMapRef != nil:
+		ref := params.ConfigConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		s, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.New(errConfigKeyMissing)
+		}
+		return s, nil
+	}
+	return "", errors.New(errNoConfig)
+}
+
+// telegrafName returns the name of the Telegraf configuration in InfluxDB.
+func telegrafName(cr *v1alpha1.Telegraf) string {
+	if cr.Spec.ForProvider.Name != nil {
+		return *cr.Spec.ForProvider.Name
+	}
+	return cr.GetName()
+}
@@ -0,0 +1,752 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telegraf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const (
+	config    = "[[inputs.cpu]]\n  percpu = true\n"
+	serverURL = "http://influxdb:8086/"
+	fetchURL  = "http://influxdb:8086/api/v2/telegrafs/id"
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.TelegrafsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTelegraf": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTelegraf),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetTelegraf),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoConfig": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return &domain.Telegraf{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				err: errors.New(errNoConfig),
+			},
+		},
+		"ConfigMapFailed": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return &domain.Telegraf{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+		"DeletedConfigMapMissing": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "agents",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("configmaps"), "cm")),
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return &domain.Telegraf{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "agents",
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return &domain.Telegraf{
+							Id: pointer.String("id"),
+							TelegrafRequest: domain.TelegrafRequest{
+								Name:        pointer.String("agents"),
+								Description: pointer.String(""),
+								Config:      pointer.String(config),
+							},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				mg: func() resource.Managed {
+					cr := &v1alpha1.Telegraf{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "agents",
+							Annotations: map[string]string{
+								meta.AnnotationKeyExternalName: "id",
+							},
+						},
+						Spec: v1alpha1.TelegrafSpec{
+							ForProvider: v1alpha1.TelegrafParameters{
+								OrgID:  pointer.String("org"),
+								Config: pointer.String(config),
+							},
+						},
+					}
+					cr.Spec.ForProvider.Description = pointer.String("")
+					cr.Status.AtProvider = v1alpha1.TelegrafObservation{ID: "id", URL: fetchURL}
+					cr.SetConditions(xpv1.Available())
+					return cr
+				}(),
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyURL: []byte(fetchURL),
+					},
+				},
+			},
+		},
+		"ConfigChangedInConfigMap": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"telegraf.conf": "[[inputs.mem]]"}
+						return nil
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					GetTelegrafByIDFn: func(_ context.Context, _ string) (*domain.Telegraf, error) {
+						return &domain.Telegraf{
+							Id: pointer.String("id"),
+							TelegrafRequest: domain.TelegrafRequest{
+								Name:   pointer.String("agents"),
+								Config: pointer.String(config),
+							},
+						}, nil
+					},
+				},
+			},
+			want: want{
+				mg: func() resource.Managed {
+					cr := &v1alpha1.Telegraf{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "agents",
+							Annotations: map[string]string{
+								meta.AnnotationKeyExternalName: "id",
+							},
+						},
+						Spec: v1alpha1.TelegrafSpec{
+							ForProvider: v1alpha1.TelegrafParameters{
+								OrgID:              pointer.String("org"),
+								ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+							},
+						},
+					}
+					cr.Status.AtProvider = v1alpha1.TelegrafObservation{ID: "id", URL: fetchURL}
+					cr.SetConditions(xpv1.Available())
+					return cr
+				}(),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						keyURL: []byte(fetchURL),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api, serverURL: serverURL}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.TelegrafsAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTelegraf": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTelegraf),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					CreateTelegrafFn: func(_ context.Context, _ domain.TelegrafRequest) (*domain.Telegraf, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateTelegraf),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					CreateTelegrafFn: func(_ context.Context, r domain.TelegrafRequest) (*domain.Telegraf, error) {
+						if pointer.StringDeref(r.Name, "") != "agents" || pointer.StringDeref(r.OrgID, "") != "org" {
+							t.Errorf("creation call has to include the name and org")
+						}
+						if pointer.StringDeref(r.Config, "") != config {
+							t.Errorf("creation call has to include the config")
+						}
+						return &domain.Telegraf{Id: pointer.String("id")}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				cre: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						keyURL: []byte(fetchURL),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{api: tc.args.api, serverURL: serverURL}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.TelegrafsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTelegraf": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTelegraf),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:  pointer.String("org"),
+							Config: pointer.String(config),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					UpdateTelegrafFn: func(_ context.Context, _ string, _ domain.TelegrafRequest) (*domain.Telegraf, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateTelegraf),
+			},
+		},
+		"SuccessWithConfigMap": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID:              pointer.String("org"),
+							ConfigConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "telegraf.conf"},
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"telegraf.conf": config}
+						return nil
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					UpdateTelegrafFn: func(_ context.Context, id string, r domain.TelegrafRequest) (*domain.Telegraf, error) {
+						if id != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if pointer.StringDeref(r.Config, "") != config {
+							t.Errorf("update call has to include the config from the ConfigMap")
+						}
+						return &domain.Telegraf{}, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.TelegrafsAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotTelegraf": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotTelegraf),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					DeleteTelegrafWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Telegraf{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "agents",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.TelegrafSpec{
+						ForProvider: v1alpha1.TelegrafParameters{
+							OrgID: pointer.String("org"),
+						},
+					},
+				},
+				api: &clients.MockTelegrafsAPI{
+					DeleteTelegrafWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteTelegraf),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
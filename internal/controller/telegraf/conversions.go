@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telegraf
+
+import (
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+const (
+	keyURL = "url"
+)
+
+// GenerateFetchURL returns the URL that Telegraf agents can fetch the
+// configuration with the given ID from.
+func GenerateFetchURL(serverURL, id string) string {
+	return strings.TrimSuffix(serverURL, "/") + "/api/v2/telegrafs/" + id
+}
+
+// GenerateTelegrafObservation converts a Telegraf response to an observation.
+func GenerateTelegrafObservation(serverURL string, t *domain.Telegraf) v1alpha1.TelegrafObservation {
+	id := pointer.StringDeref(t.Id, "")
+	return v1alpha1.TelegrafObservation{
+		ID:  id,
+		URL: GenerateFetchURL(serverURL, id),
+	}
+}
+
+// GenerateTelegrafRequest returns a TelegrafRequest that the InfluxDB API
+// accepts for creation and update.
+func GenerateTelegrafRequest(name, config string, params v1alpha1.TelegrafParameters) domain.TelegrafRequest {
+	return domain.TelegrafRequest{
+		Name:        pointer.String(name),
+		Description: params.Description,
+		OrgID:       params.OrgID,
+		Config:      pointer.String(config),
+	}
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.TelegrafParameters, obs *domain.Telegraf) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name, config string, params v1alpha1.TelegrafParameters, obs *domain.Telegraf) bool {
+	return name == pointer.StringDeref(obs.Name, "") &&
+		pointer.StringDeref(params.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		strings.TrimSpace(config) == strings.TrimSpace(pointer.StringDeref(obs.Config, ""))
+}
+
+// GetConnectionDetails returns the connection details of the Telegraf
+// configuration with the given fetch URL.
+func GetConnectionDetails(url string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		keyURL: []byte(url),
+	}
+}
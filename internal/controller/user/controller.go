@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+const (
+	errNotUser            = "managed resource is not a User custom resource"
+	errFindUser           = "cannot find user"
+	errCreateUser         = "cannot create user"
+	errUpdateUser         = "cannot update user"
+	errUpdatePassword     = "cannot update password of user"
+	errDeleteUser         = "cannot delete user"
+	errGetPasswordSecret  = "cannot get password secret"
+	errPasswordKeyMissing = "password key is not found in the referenced secret"
+)
+
+// Setup adds a controller that reconciles User managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.UserGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UserGroupVersionKind),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.User{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cl, err := clients.NewClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create a new client")
+	}
+	return &external{kube: c.kube, api: cl.UsersAPI()}, nil
+}
+
+type external struct {
+	kube client.Client
+	api  clients.UsersAPI
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	user, err := c.api.FindUserByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(IsNotFoundFn(meta.GetExternalName(cr)), err), errFindUser)
+	}
+	// The password Secret is often deleted together with the resource, so it
+	// is not read when only the deletion is left.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	obs := GenerateUserObservation(user)
+	obs.PasswordSecretVersion = cr.Status.AtProvider.PasswordSecretVersion
+	cr.Status.AtProvider = obs
+	switch cr.Status.AtProvider.Status {
+	// Empty string also means active.
+	case string(domain.UserStatusActive), "":
+		cr.SetConditions(v1.Available())
+	case string(domain.UserStatusInactive):
+		cr.SetConditions(v1.Unavailable())
+	}
+
+	pwUpToDate := true
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		s, err := c.getPasswordSecret(ctx, *cr.Spec.ForProvider.PasswordSecretRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		pwUpToDate = clients.SecretVersion(s) == cr.Status.AtProvider.PasswordSecretVersion
+	}
+
+	li := LateInitialize(&cr.Spec.ForProvider, user)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        pwUpToDate && IsUpToDate(cr.Spec.ForProvider, user),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	user, err := c.api.CreateUser(ctx, GenerateUser(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+	return managed.ExternalCreation{}, c.setPassword(ctx, cr, pointer.StringDeref(user.Id, ""))
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+
+	u := GenerateUser(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	u.Id = &cr.Status.AtProvider.ID
+	if _, err := c.api.UpdateUser(ctx, u); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUser)
+	}
+	return managed.ExternalUpdate{}, c.setPassword(ctx, cr, cr.Status.AtProvider.ID)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+	err := c.api.DeleteUserWithID(ctx, cr.Status.AtProvider.ID)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteUser)
+}
+
+func (c *external) getPasswordSecret(ctx context.Context, ref v1.SecretKeySelector) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetPasswordSecret)
+	}
+	if _, ok := s.Data[ref.Key]; !ok {
+		return nil, errors.New(errPasswordKeyMissing)
+	}
+	return s, nil
+}
+
+// setPassword applies the password in the referenced Secret, if any, and
+// records the version of the Secret that was applied.
+func (c *external) setPassword(ctx context.Context, cr *v1alpha1.User, id string) error {
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	if ref == nil {
+		return nil
+	}
+	s, err := c.getPasswordSecret(ctx, *ref)
+	if err != nil {
+		return err
+	}
+	if err := c.api.UpdateUserPasswordWithID(ctx, id, string(s.Data[ref.Key])); err != nil {
+		return errors.Wrap(err, errUpdatePassword)
+	}
+	cr.Status.AtProvider.PasswordSecretVersion = clients.SecretVersion(s)
+	return nil
+}
@@ -0,0 +1,463 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func withPasswordSecretRef(version string) *v1alpha1.User {
+	return &v1alpha1.User{
+		Spec: v1alpha1.UserSpec{
+			ForProvider: v1alpha1.UserParameters{
+				Status: pointer.String("active"),
+				PasswordSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "pw", Namespace: "ns"},
+					Key:             "password",
+				},
+			},
+		},
+		Status: v1alpha1.UserStatus{
+			AtProvider: v1alpha1.UserObservation{
+				ID:                    "id",
+				PasswordSecretVersion: version,
+			},
+		},
+	}
+}
+
+func mockSecretGet(version string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.SetResourceVersion(version)
+		s.Data = map[string][]byte{"password": []byte("s3cr3t")}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	active := domain.UserStatusActive
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.UsersAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotUser": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"FindFailed": {
+			args: args{
+				mg: &v1alpha1.User{},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, _ string) (*domain.User, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errFindUser),
+			},
+		},
+		"NotFoundCreationNeeded": {
+			args: args{
+				mg: &v1alpha1.User{},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, name string) (*domain.User, error) {
+						return nil, fmt.Errorf("user '%s' not found", name)
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				mg: withPasswordSecretRef("1"),
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, _ string) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("id"), Status: &active}, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPasswordSecret),
+			},
+		},
+		"DeletedSecretMissing": {
+			args: args{
+				mg: &v1alpha1.User{
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Unix(1, 0)},
+					},
+					Spec: v1alpha1.UserSpec{
+						ForProvider: v1alpha1.UserParameters{
+							Status: pointer.String("active"),
+							PasswordSecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "pw", Namespace: "ns"},
+								Key:             "password",
+							},
+						},
+					},
+					Status: v1alpha1.UserStatus{
+						AtProvider: v1alpha1.UserObservation{
+							ID:                    "id",
+							PasswordSecretVersion: "1",
+						},
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("secrets"), "pw")),
+				},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, _ string) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("id"), Status: &active}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				mg: withPasswordSecretRef("1"),
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("2"),
+				},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, _ string) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("id"), Status: &active}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: withPasswordSecretRef("1"),
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockUsersAPI{
+					FindUserByNameFn: func(_ context.Context, _ string) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("id"), Status: &active}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.UsersAPI
+	}
+	type want struct {
+		err error
+		cre managed.ExternalCreation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotUser": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.User{},
+				api: &clients.MockUsersAPI{
+					CreateUserFn: func(_ context.Context, _ *domain.User) (*domain.User, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateUser),
+			},
+		},
+		"SetPasswordFailed": {
+			args: args{
+				mg: withPasswordSecretRef(""),
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockUsersAPI{
+					CreateUserFn: func(_ context.Context, _ *domain.User) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("id")}, nil
+					},
+					UpdateUserPasswordWithIDFn: func(_ context.Context, _ string, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdatePassword),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: withPasswordSecretRef(""),
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("1"),
+				},
+				api: &clients.MockUsersAPI{
+					CreateUserFn: func(_ context.Context, _ *domain.User) (*domain.User, error) {
+						return &domain.User{Id: pointer.String("newid")}, nil
+					},
+					UpdateUserPasswordWithIDFn: func(_ context.Context, id string, pw string) error {
+						if id != "newid" || pw != "s3cr3t" {
+							t.Errorf("password has to be set on the created user")
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cre, err := (&external{kube: tc.args.kube, api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg   resource.Managed
+		kube client.Client
+		api  clients.UsersAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+		obs managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotUser": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotUser),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.User{},
+				api: &clients.MockUsersAPI{
+					UpdateUserFn: func(_ context.Context, _ *domain.User) (*domain.User, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg:  &v1alpha1.User{},
+				err: errors.Wrap(errBoom, errUpdateUser),
+			},
+		},
+		"PasswordApplied": {
+			args: args{
+				mg: withPasswordSecretRef("1"),
+				kube: &test.MockClient{
+					MockGet: mockSecretGet("2"),
+				},
+				api: &clients.MockUsersAPI{
+					UpdateUserFn: func(_ context.Context, u *domain.User) (*domain.User, error) {
+						return u, nil
+					},
+					UpdateUserPasswordWithIDFn: func(_ context.Context, _ string, _ string) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				mg: withPasswordSecretRef("2"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{kube: tc.args.kube, api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.UsersAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotUser": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotUser),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.User{
+					Status: v1alpha1.UserStatus{
+						AtProvider: v1alpha1.UserObservation{
+							ID: "testid",
+						},
+					},
+				},
+				api: &clients.MockUsersAPI{
+					DeleteUserWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.User{},
+				api: &clients.MockUsersAPI{
+					DeleteUserWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
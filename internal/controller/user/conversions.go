@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+)
+
+// GenerateUserObservation converts a User response to an observation.
+func GenerateUserObservation(u *domain.User) v1alpha1.UserObservation {
+	o := v1alpha1.UserObservation{
+		ID:      pointer.StringDeref(u.Id, ""),
+		OAuthID: pointer.StringDeref(u.OauthID, ""),
+	}
+	if u.Status != nil {
+		o.Status = string(*u.Status)
+	}
+	return o
+}
+
+// GenerateUser returns a User model that the InfluxDB API accepts for creation
+// and update.
+func GenerateUser(name string, params v1alpha1.UserParameters) *domain.User {
+	out := &domain.User{
+		Name: name,
+	}
+	if params.Status != nil {
+		s := domain.UserStatus(*params.Status)
+		out.Status = &s
+	}
+	return out
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.UserParameters, obs *domain.User) bool {
+	li := resource.NewLateInitializer()
+	if params.Status == nil && obs.Status != nil {
+		params.Status = pointer.String(string(*obs.Status))
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(params v1alpha1.UserParameters, obs *domain.User) bool {
+	observed := string(domain.UserStatusActive)
+	if obs.Status != nil {
+		observed = string(*obs.Status)
+	}
+	return pointer.StringDeref(params.Status, string(domain.UserStatusActive)) == observed
+}
+
+// IsNotFoundFn returns an ErrorIs function that can tell whether the error is
+// of kind NotFound.
+func IsNotFoundFn(name string) resource.ErrorIs {
+	return func(err error) bool {
+		return strings.Contains(err.Error(), fmt.Sprintf(`user '%s' not found`, name))
+	}
+}
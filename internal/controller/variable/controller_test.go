@@ -0,0 +1,710 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variable
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apihttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+// observed returns the variable as it'd be returned by the API.
+func observed(t *testing.T, cr *v1alpha1.Variable) *clients.Variable {
+	v, err := GenerateVariable(variableName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.Id = pointer.String("id")
+	v.Description = pointer.String("")
+	return v
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.VariablesAPI
+	}
+	type want struct {
+		err error
+		obs managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotVariable": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotVariable),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetVariable),
+			},
+		},
+		"NotFound": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return nil, &apihttp.Error{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoTypeConfig": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return observed(t, &v1alpha1.Variable{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "hosts",
+							},
+							Spec: v1alpha1.VariableSpec{
+								ForProvider: v1alpha1.VariableParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.VariableTypeQuery,
+									Query: &v1alpha1.QueryVariableParameters{
+										Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+										Language: "flux",
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Errorf(errNoTypeConfig, v1alpha1.VariableTypeQuery), errGenerateVariable),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return observed(t, &v1alpha1.Variable{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "hosts",
+							},
+							Spec: v1alpha1.VariableSpec{
+								ForProvider: v1alpha1.VariableParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.VariableTypeQuery,
+									Query: &v1alpha1.QueryVariableParameters{
+										Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+										Language: "flux",
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"SelectedLateInitialized": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID:    pointer.String("org"),
+							Type:     v1alpha1.VariableTypeConstant,
+							Constant: []string{"a", "b"},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return observed(t, &v1alpha1.Variable{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "hosts",
+							},
+							Spec: v1alpha1.VariableSpec{
+								ForProvider: v1alpha1.VariableParameters{
+									OrgID:    pointer.String("org"),
+									Type:     v1alpha1.VariableTypeConstant,
+									Constant: []string{"a", "b"},
+									Selected: []string{"a"},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ValuesChanged": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID:    pointer.String("org"),
+							Type:     v1alpha1.VariableTypeConstant,
+							Constant: []string{"a", "b", "c"},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return observed(t, &v1alpha1.Variable{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "hosts",
+							},
+							Spec: v1alpha1.VariableSpec{
+								ForProvider: v1alpha1.VariableParameters{
+									OrgID:    pointer.String("org"),
+									Type:     v1alpha1.VariableTypeConstant,
+									Constant: []string{"a", "b"},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TypeChanged": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID:    pointer.String("org"),
+							Type:     v1alpha1.VariableTypeConstant,
+							Constant: []string{"a"},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					GetVariableByIDFn: func(_ context.Context, _ string) (*clients.Variable, error) {
+						return observed(t, &v1alpha1.Variable{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "hosts",
+							},
+							Spec: v1alpha1.VariableSpec{
+								ForProvider: v1alpha1.VariableParameters{
+									OrgID: pointer.String("org"),
+									Type:  v1alpha1.VariableTypeQuery,
+									Query: &v1alpha1.QueryVariableParameters{
+										Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+										Language: "flux",
+									},
+								},
+							},
+						}), nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := (&external{api: tc.args.api}).Observe(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.VariablesAPI
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotVariable": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotVariable),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					CreateVariableFn: func(_ context.Context, _ *clients.Variable) (*clients.Variable, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				err: errors.Wrap(errBoom, errCreateVariable),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					CreateVariableFn: func(_ context.Context, v *clients.Variable) (*clients.Variable, error) {
+						if v.Name != "hosts" || v.OrgID != "org" {
+							t.Errorf("creation call has to include the name and org")
+						}
+						if v.Arguments.Type != v1alpha1.VariableTypeQuery || v.Arguments.Query == nil {
+							t.Errorf("creation call has to include the query arguments")
+						}
+						return &clients.Variable{Variable: domain.Variable{Id: pointer.String("id")}}, nil
+					},
+				},
+			},
+			want: want{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Create(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.VariablesAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotVariable": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotVariable),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					UpdateVariableFn: func(_ context.Context, _ *clients.Variable) (*clients.Variable, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateVariable),
+			},
+		},
+		"Success": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "id",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID:    pointer.String("org"),
+							Type:     v1alpha1.VariableTypeConstant,
+							Constant: []string{"a", "b"},
+							Selected: []string{"b"},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					UpdateVariableFn: func(_ context.Context, v *clients.Variable) (*clients.Variable, error) {
+						if pointer.StringDeref(v.Id, "") != "id" {
+							t.Errorf("update call has to use the external name as id")
+						}
+						if diff := cmp.Diff([]string{"a", "b"}, v.Arguments.Constant); diff != "" {
+							t.Errorf("update call has to include the desired values: %s", diff)
+						}
+						if v.Selected == nil || len(*v.Selected) != 1 {
+							t.Errorf("update call has to include the selected values")
+						}
+						return v, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&external{api: tc.args.api}).Update(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		mg  resource.Managed
+		api clients.VariablesAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotVariable": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{
+				err: errors.New(errNotVariable),
+			},
+		},
+		"DeleteWithCorrectID": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					DeleteVariableWithIDFn: func(_ context.Context, id string) error {
+						if id != "testid" {
+							t.Errorf("deletion call has to use the id for deletion")
+						}
+						return nil
+					},
+				},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				mg: &v1alpha1.Variable{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hosts",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "testid",
+						},
+					},
+					Spec: v1alpha1.VariableSpec{
+						ForProvider: v1alpha1.VariableParameters{
+							OrgID: pointer.String("org"),
+							Type:  v1alpha1.VariableTypeQuery,
+							Query: &v1alpha1.QueryVariableParameters{
+								Query:    `import "influxdata/influxdb/schema" schema.tagValues(bucket: "telegraf", tag: "host")`,
+								Language: "flux",
+							},
+						},
+					},
+				},
+				api: &clients.MockVariablesAPI{
+					DeleteVariableWithIDFn: func(_ context.Context, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteVariable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&external{api: tc.args.api}).Delete(context.TODO(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variable
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane-contrib/provider-influxdb/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-influxdb/internal/clients"
+)
+
+// GenerateVariableObservation converts a Variable response to an
+// observation.
+func GenerateVariableObservation(v *clients.Variable) v1alpha1.VariableObservation {
+	o := v1alpha1.VariableObservation{
+		ID: pointer.StringDeref(v.Id, ""),
+	}
+	if v.CreatedAt != nil {
+		o.CreatedAt = metav1.NewTime(*v.CreatedAt)
+	}
+	if v.UpdatedAt != nil {
+		o.UpdatedAt = metav1.NewTime(*v.UpdatedAt)
+	}
+	return o
+}
+
+// GenerateVariable returns a Variable model that the InfluxDB API accepts for
+// creation and update.
+func GenerateVariable(name string, params v1alpha1.VariableParameters) (*clients.Variable, error) {
+	out := &clients.Variable{
+		Variable: domain.Variable{
+			Name:        name,
+			Description: params.Description,
+			OrgID:       pointer.StringDeref(params.OrgID, ""),
+		},
+		Arguments: clients.VariableArguments{Type: params.Type},
+	}
+	if params.Selected != nil {
+		selected := params.Selected
+		out.Selected = &selected
+	}
+	switch params.Type {
+	case v1alpha1.VariableTypeQuery:
+		if params.Query == nil {
+			return nil, errors.Errorf(errNoTypeConfig, params.Type)
+		}
+		out.Arguments.Query = &clients.QueryVariableValues{
+			Query:    params.Query.Query,
+			Language: params.Query.Language,
+		}
+	case v1alpha1.VariableTypeConstant:
+		out.Arguments.Constant = params.Constant
+	case v1alpha1.VariableTypeMap:
+		out.Arguments.Map = params.Map
+	}
+	return out, nil
+}
+
+// LateInitialize sets the defaults from the API if user didn't set a value for
+// such fields.
+func LateInitialize(params *v1alpha1.VariableParameters, obs *clients.Variable) bool {
+	li := resource.NewLateInitializer()
+	params.Description = li.LateInitializeStringPtr(params.Description, obs.Description)
+	if params.Selected == nil && obs.Selected != nil && len(*obs.Selected) != 0 {
+		params.Selected = *obs.Selected
+		li.SetChanged()
+	}
+	return li.IsChanged()
+}
+
+// IsUpToDate returns whether an update call is necessary.
+func IsUpToDate(name string, params v1alpha1.VariableParameters, obs *clients.Variable) (bool, error) {
+	desired, err := GenerateVariable(name, params)
+	if err != nil {
+		return false, err
+	}
+	return desired.Name == obs.Name &&
+		pointer.StringDeref(desired.Description, "") == pointer.StringDeref(obs.Description, "") &&
+		isStringsUpToDate(selected(desired.Selected), selected(obs.Selected)) &&
+		isArgumentsUpToDate(desired.Arguments, obs.Arguments), nil
+}
+
+func isArgumentsUpToDate(desired, obs clients.VariableArguments) bool {
+	if desired.Type != obs.Type ||
+		!isStringsUpToDate(desired.Constant, obs.Constant) ||
+		len(desired.Map) != len(obs.Map) {
+		return false
+	}
+	for k, v := range desired.Map {
+		if ov, ok := obs.Map[k]; !ok || ov != v {
+			return false
+		}
+	}
+	if desired.Query == nil || obs.Query == nil {
+		return desired.Query == obs.Query
+	}
+	return *desired.Query == *obs.Query
+}
+
+func isStringsUpToDate(desired, obs []string) bool {
+	if len(desired) != len(obs) {
+		return false
+	}
+	for i := range desired {
+		if desired[i] != obs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func selected(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+	return *s
+}